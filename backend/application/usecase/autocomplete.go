@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+)
+
+const (
+	defaultCompletionLimit = 10
+	maxCompletionLimit     = 20
+)
+
+type AutocompleteRequest struct {
+	Prefix      string
+	ContentType *entity.ContentType
+	Limit       int32
+}
+
+type AutocompleteUseCase struct {
+	contentRepo ports.ContentRepository
+	tagRepo     ports.TagRepository
+	cacheClient ports.CacheClient
+	logger      ports.Logger
+	cacheTTL    time.Duration
+}
+
+func NewAutocompleteUseCase(
+	contentRepo ports.ContentRepository,
+	tagRepo ports.TagRepository,
+	cacheClient ports.CacheClient,
+	logger ports.Logger,
+	cacheTTL time.Duration,
+) *AutocompleteUseCase {
+	return &AutocompleteUseCase{
+		contentRepo: contentRepo,
+		tagRepo:     tagRepo,
+		cacheClient: cacheClient,
+		logger:      logger,
+		cacheTTL:    cacheTTL,
+	}
+}
+
+// Execute returns completions for a partially typed query: matching tags
+// first, as they make good search terms, then matching titles.
+func (uc *AutocompleteUseCase) Execute(ctx context.Context, req AutocompleteRequest) ([]entity.Completion, error) {
+	prefix := strings.ToLower(strings.TrimSpace(req.Prefix))
+	if prefix == "" {
+		return []entity.Completion{}, nil
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCompletionLimit
+	}
+	if limit > maxCompletionLimit {
+		limit = maxCompletionLimit
+	}
+
+	cacheKey := uc.buildCacheKey(prefix, req.ContentType, limit)
+
+	var cached []entity.Completion
+	found, err := uc.cacheClient.Get(ctx, cacheKey, &cached)
+	if err == nil && found {
+		return cached, nil
+	}
+
+	tags, err := uc.tagRepo.AutocompleteTags(ctx, prefix, req.ContentType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete tags: %w", err)
+	}
+
+	titles, err := uc.contentRepo.AutocompleteTitles(ctx, prefix, req.ContentType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete titles: %w", err)
+	}
+
+	completions := make([]entity.Completion, 0, limit)
+	seen := make(map[string]bool, len(tags)+len(titles))
+	add := func(text string, kind entity.CompletionKind) {
+		key := strings.ToLower(text)
+		if int32(len(completions)) >= limit || seen[key] {
+			return
+		}
+		seen[key] = true
+		completions = append(completions, entity.Completion{Text: text, Kind: kind})
+	}
+	for _, tag := range tags {
+		add(tag, entity.CompletionKindTag)
+	}
+	for _, title := range titles {
+		add(title, entity.CompletionKindTitle)
+	}
+
+	if err := uc.cacheClient.Set(ctx, cacheKey, completions, uc.cacheTTL); err != nil {
+		uc.logger.Warn("failed to cache completions", loggerPkg.String("error", err.Error()))
+	}
+
+	return completions, nil
+}
+
+func (uc *AutocompleteUseCase) buildCacheKey(prefix string, contentType *entity.ContentType, limit int32) string {
+	typeStr := "all"
+	if contentType != nil {
+		typeStr = string(*contentType)
+	}
+	return fmt.Sprintf("suggest:%s:%s:%d", prefix, typeStr, limit)
+}
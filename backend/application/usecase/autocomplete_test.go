@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAutocompleteUseCase_Execute(t *testing.T) {
+	mockContentRepo := new(MockContentRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockCache := new(MockCacheClient)
+	mockLogger := new(MockLogger)
+
+	uc := NewAutocompleteUseCase(mockContentRepo, mockTagRepo, mockCache, mockLogger, 30*time.Second)
+	ctx := context.Background()
+
+	t.Run("Empty Prefix", func(t *testing.T) {
+		completions, err := uc.Execute(ctx, AutocompleteRequest{Prefix: "   "})
+		assert.NoError(t, err)
+		assert.Empty(t, completions)
+	})
+
+	t.Run("Cache Hit", func(t *testing.T) {
+		cached := []entity.Completion{{Text: "golang", Kind: entity.CompletionKindTag}}
+		mockCache.On("Get", ctx, "suggest:gol:all:10", mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
+			dest := args.Get(2).(*[]entity.Completion)
+			*dest = cached
+		}).Once()
+
+		completions, err := uc.Execute(ctx, AutocompleteRequest{Prefix: "Gol"})
+		assert.NoError(t, err)
+		assert.Equal(t, cached, completions)
+	})
+
+	t.Run("Merges Tags And Titles", func(t *testing.T) {
+		mockCache.On("Get", ctx, "suggest:go:all:3", mock.Anything).Return(false, nil).Once()
+		mockTagRepo.On("AutocompleteTags", ctx, "go", (*entity.ContentType)(nil), int32(3)).Return([]string{"go", "golang"}, nil).Once()
+		mockContentRepo.On("AutocompleteTitles", ctx, "go", (*entity.ContentType)(nil), int32(3)).Return([]string{"Go", "Going Further"}, nil).Once()
+		mockCache.On("Set", ctx, "suggest:go:all:3", mock.Anything, 30*time.Second).Return(nil).Once()
+
+		completions, err := uc.Execute(ctx, AutocompleteRequest{Prefix: "go", Limit: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Completion{
+			{Text: "go", Kind: entity.CompletionKindTag},
+			{Text: "golang", Kind: entity.CompletionKindTag},
+			{Text: "Going Further", Kind: entity.CompletionKindTitle},
+		}, completions)
+	})
+
+	t.Run("Limit Is Capped", func(t *testing.T) {
+		mockCache.On("Get", ctx, "suggest:vid:all:20", mock.Anything).Return(false, nil).Once()
+		mockTagRepo.On("AutocompleteTags", ctx, "vid", (*entity.ContentType)(nil), int32(20)).Return([]string{}, nil).Once()
+		mockContentRepo.On("AutocompleteTitles", ctx, "vid", (*entity.ContentType)(nil), int32(20)).Return([]string{}, nil).Once()
+		mockCache.On("Set", ctx, "suggest:vid:all:20", mock.Anything, 30*time.Second).Return(nil).Once()
+
+		completions, err := uc.Execute(ctx, AutocompleteRequest{Prefix: "vid", Limit: 500})
+		assert.NoError(t, err)
+		assert.Empty(t, completions)
+	})
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+var ErrProviderInUse = errors.New("provider has contents")
+
+type DeleteProviderUseCase struct {
+	providerRepo ports.ProviderRepository
+}
+
+func NewDeleteProviderUseCase(providerRepo ports.ProviderRepository) *DeleteProviderUseCase {
+	return &DeleteProviderUseCase{
+		providerRepo: providerRepo,
+	}
+}
+
+// Execute deletes a provider that never synced any content. Providers with
+// contents can only be disabled, as search results still reference them.
+func (uc *DeleteProviderUseCase) Execute(ctx context.Context, code string) error {
+	provider, err := uc.providerRepo.GetByCode(ctx, code)
+	if err != nil {
+		return fmt.Errorf("get provider: %w", err)
+	}
+	if provider == nil {
+		return ErrProviderNotFound
+	}
+
+	hasContents, err := uc.providerRepo.HasContents(ctx, provider.ID)
+	if err != nil {
+		return fmt.Errorf("check provider contents: %w", err)
+	}
+	if hasContents {
+		return ErrProviderInUse
+	}
+
+	if err := uc.providerRepo.Delete(ctx, provider.ID); err != nil {
+		return fmt.Errorf("delete provider: %w", err)
+	}
+	return nil
+}
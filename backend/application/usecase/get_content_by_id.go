@@ -16,17 +16,23 @@ type GetContentByIDRequest struct {
 type GetContentByIDUseCase struct {
 	contentRepo      ports.ContentRepository
 	contentStatsRepo ports.ContentStatsRepository
+	tagRepo          ports.TagRepository
+	providerRepo     ports.ProviderRepository
 	scoringService   *service.ScoringService
 }
 
 func NewGetContentByIDUseCase(
 	contentRepo ports.ContentRepository,
 	contentStatsRepo ports.ContentStatsRepository,
+	tagRepo ports.TagRepository,
+	providerRepo ports.ProviderRepository,
 	scoringService *service.ScoringService,
 ) *GetContentByIDUseCase {
 	return &GetContentByIDUseCase{
 		contentRepo:      contentRepo,
 		contentStatsRepo: contentStatsRepo,
+		tagRepo:          tagRepo,
+		providerRepo:     providerRepo,
 		scoringService:   scoringService,
 	}
 }
@@ -48,11 +54,23 @@ func (uc *GetContentByIDUseCase) Execute(ctx context.Context, req GetContentByID
 		stats = &entity.ContentStats{ContentID: content.ID}
 	}
 
+	tags, err := uc.tagRepo.GetByContentID(ctx, content.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get content tags: %w", err)
+	}
+
+	provider, err := uc.providerRepo.GetByID(ctx, content.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+
 	score := uc.scoringService.Calculate(*content, *stats)
 
 	return &ContentWithScore{
-		Content: *content,
-		Stats:   *stats,
-		Score:   score,
+		Content:  *content,
+		Stats:    *stats,
+		Score:    score,
+		Tags:     tags,
+		Provider: provider,
 	}, nil
 }
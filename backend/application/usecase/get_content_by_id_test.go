@@ -13,6 +13,8 @@ import (
 func TestGetContentByIDUseCase_Execute(t *testing.T) {
 	mockContentRepo := new(MockContentRepository)
 	mockStatsRepo := new(MockContentStatsRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockProviderRepo := new(MockProviderRepository)
 
 	scoringConfig := entity.ScoringConfig{}
 	timeProvider := func() time.Time { return time.Now() }
@@ -21,22 +23,29 @@ func TestGetContentByIDUseCase_Execute(t *testing.T) {
 	uc := NewGetContentByIDUseCase(
 		mockContentRepo,
 		mockStatsRepo,
+		mockTagRepo,
+		mockProviderRepo,
 		scoringService,
 	)
 
 	ctx := context.Background()
 
 	t.Run("Found", func(t *testing.T) {
-		content := &entity.Content{ID: 1, Title: "Test"}
+		content := &entity.Content{ID: 1, ProviderID: 2, Title: "Test"}
 		mockContentRepo.On("GetByID", ctx, int64(1)).Return(content, nil)
+		provider := &entity.Provider{ID: 2, Code: "provider2", Name: "Provider Two"}
+		mockProviderRepo.On("GetByID", ctx, int64(2)).Return(provider, nil)
 
 		stats := &entity.ContentStats{ContentID: 1, Views: 100}
 		mockStatsRepo.On("GetByContentID", ctx, int64(1)).Return(stats, nil)
+		mockTagRepo.On("GetByContentID", ctx, int64(1)).Return([]entity.Tag{{ID: 3, Name: "golang"}}, nil)
 
 		res, err := uc.Execute(ctx, GetContentByIDRequest{ID: 1})
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 		assert.Equal(t, int64(1), res.Content.ID)
+		assert.Equal(t, []entity.Tag{{ID: 3, Name: "golang"}}, res.Tags)
+		assert.Equal(t, provider, res.Provider)
 	})
 
 	t.Run("Not Found", func(t *testing.T) {
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type GetContentRawPayloadRequest struct {
+	ContentID int64
+}
+
+type GetContentRawPayloadUseCase struct {
+	rawPayloadRepo ports.ContentRawPayloadRepository
+}
+
+func NewGetContentRawPayloadUseCase(rawPayloadRepo ports.ContentRawPayloadRepository) *GetContentRawPayloadUseCase {
+	return &GetContentRawPayloadUseCase{
+		rawPayloadRepo: rawPayloadRepo,
+	}
+}
+
+func (uc *GetContentRawPayloadUseCase) Execute(ctx context.Context, req GetContentRawPayloadRequest) (*entity.ContentRawPayload, error) {
+	payload, err := uc.rawPayloadRepo.GetByContentID(ctx, req.ContentID)
+	if err != nil {
+		return nil, fmt.Errorf("get raw payload: %w", err)
+	}
+	return payload, nil
+}
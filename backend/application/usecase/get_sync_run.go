@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type GetSyncRunRequest struct {
+	ID int64
+}
+
+type GetSyncRunUseCase struct {
+	providerRepo ports.ProviderRepository
+	syncRunRepo  ports.SyncRunRepository
+}
+
+func NewGetSyncRunUseCase(
+	providerRepo ports.ProviderRepository,
+	syncRunRepo ports.SyncRunRepository,
+) *GetSyncRunUseCase {
+	return &GetSyncRunUseCase{
+		providerRepo: providerRepo,
+		syncRunRepo:  syncRunRepo,
+	}
+}
+
+func (uc *GetSyncRunUseCase) Execute(ctx context.Context, req GetSyncRunRequest) (*SyncRunWithProvider, error) {
+	run, err := uc.syncRunRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get sync run: %w", err)
+	}
+	if run == nil {
+		return nil, nil
+	}
+
+	provider, err := uc.providerRepo.GetByID(ctx, run.ProviderID)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+	if provider == nil {
+		provider = &entity.Provider{ID: run.ProviderID}
+	}
+
+	return &SyncRunWithProvider{
+		Run:      *run,
+		Provider: *provider,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+)
+
+// LeaderElection runs a task on exactly one replica at a time. Replicas
+// campaign for a shared lock; the holder runs the task until the lock is lost
+// or ctx ends, after which another replica takes over.
+type LeaderElection struct {
+	locker        ports.Locker
+	key           string
+	logger        ports.Logger
+	checkInterval time.Duration
+}
+
+func NewLeaderElection(
+	locker ports.Locker,
+	key string,
+	logger ports.Logger,
+	checkInterval time.Duration,
+) *LeaderElection {
+	return &LeaderElection{
+		locker:        locker,
+		key:           key,
+		logger:        logger,
+		checkInterval: checkInterval,
+	}
+}
+
+// Run campaigns every check interval and runs task while leading. It returns
+// once ctx is cancelled and any running task has returned.
+func (e *LeaderElection) Run(ctx context.Context, task func(ctx context.Context)) {
+	for {
+		lock, err := e.locker.TryLock(ctx, e.key)
+		if err != nil && ctx.Err() == nil {
+			e.logger.Error("leader election failed", loggerPkg.String("key", e.key), loggerPkg.Error(err))
+		}
+		if lock != nil {
+			e.lead(ctx, lock, task)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.checkInterval):
+		}
+	}
+}
+
+func (e *LeaderElection) lead(ctx context.Context, lock ports.Lock, task func(ctx context.Context)) {
+	e.logger.Info("acquired leadership", loggerPkg.String("key", e.key))
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		task(leaderCtx)
+	}()
+
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+
+watch:
+	for {
+		select {
+		case <-done:
+			break watch
+		case <-ctx.Done():
+			break watch
+		case <-ticker.C:
+			if err := lock.Check(ctx); err != nil {
+				e.logger.Error("lost leadership", loggerPkg.String("key", e.key), loggerPkg.Error(err))
+				break watch
+			}
+		}
+	}
+
+	cancel()
+	<-done
+
+	if err := lock.Release(context.WithoutCancel(ctx)); err != nil {
+		e.logger.Warn("failed to release leadership", loggerPkg.String("key", e.key), loggerPkg.Error(err))
+	}
+	e.logger.Info("stepped down as leader", loggerPkg.String("key", e.key))
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaderElection_Run(t *testing.T) {
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	t.Run("Follower Never Runs Task", func(t *testing.T) {
+		mockLocker := new(MockLocker)
+		mockLocker.On("TryLock", mock.Anything, "sync:leader").Return(nil, nil)
+		election := NewLeaderElection(mockLocker, "sync:leader", mockLogger, 5*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+
+		ran := false
+		election.Run(ctx, func(context.Context) { ran = true })
+		assert.False(t, ran)
+	})
+
+	t.Run("Leader Steps Down When Lock Is Lost", func(t *testing.T) {
+		mockLock := new(MockLock)
+		mockLock.On("Check", mock.Anything).Return(errors.New("connection reset")).Once()
+		mockLock.On("Release", mock.Anything).Return(nil).Once()
+		mockLocker := new(MockLocker)
+		mockLocker.On("TryLock", mock.Anything, "sync:leader").Return(mockLock, nil).Once()
+		mockLocker.On("TryLock", mock.Anything, "sync:leader").Return(nil, nil)
+		election := NewLeaderElection(mockLocker, "sync:leader", mockLogger, 5*time.Millisecond)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stopped := make(chan struct{})
+		go election.Run(ctx, func(taskCtx context.Context) {
+			<-taskCtx.Done()
+			close(stopped)
+		})
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatal("task kept running after the lock was lost")
+		}
+		cancel()
+		assert.Eventually(t, func() bool {
+			return mockLock.AssertExpectations(new(testing.T))
+		}, time.Second, 5*time.Millisecond)
+	})
+}
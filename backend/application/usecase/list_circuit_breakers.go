@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type ProviderCircuitBreaker struct {
+	Provider entity.Provider
+	Breaker  ports.CircuitBreakerState
+}
+
+type ListCircuitBreakersUseCase struct {
+	providerRepo ports.ProviderRepository
+	breakers     ports.CircuitBreakers
+}
+
+func NewListCircuitBreakersUseCase(providerRepo ports.ProviderRepository, breakers ports.CircuitBreakers) *ListCircuitBreakersUseCase {
+	return &ListCircuitBreakersUseCase{
+		providerRepo: providerRepo,
+		breakers:     breakers,
+	}
+}
+
+// Execute returns the circuit breaker of every provider, disabled ones
+// included, in the provider order of ListAll.
+func (uc *ListCircuitBreakersUseCase) Execute(ctx context.Context) ([]ProviderCircuitBreaker, error) {
+	providers, err := uc.providerRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list providers: %w", err)
+	}
+
+	breakers := make([]ProviderCircuitBreaker, 0, len(providers))
+	for _, provider := range providers {
+		breakers = append(breakers, ProviderCircuitBreaker{
+			Provider: provider,
+			Breaker:  uc.breakers.State(provider.Code),
+		})
+	}
+	return breakers, nil
+}
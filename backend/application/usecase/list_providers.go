@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type ListProvidersUseCase struct {
+	providerRepo ports.ProviderRepository
+}
+
+func NewListProvidersUseCase(providerRepo ports.ProviderRepository) *ListProvidersUseCase {
+	return &ListProvidersUseCase{
+		providerRepo: providerRepo,
+	}
+}
+
+func (uc *ListProvidersUseCase) Execute(ctx context.Context) ([]entity.Provider, error) {
+	providers, err := uc.providerRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list providers: %w", err)
+	}
+	return providers, nil
+}
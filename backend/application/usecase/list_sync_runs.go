@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+var ErrProviderNotFound = errors.New("provider not found")
+
+const (
+	defaultSyncRunLimit = 20
+	maxSyncRunLimit     = 100
+)
+
+type ListSyncRunsRequest struct {
+	ProviderCode string
+	Limit        int32
+}
+
+type SyncRunWithProvider struct {
+	Run      entity.SyncRun
+	Provider entity.Provider
+}
+
+type ListSyncRunsUseCase struct {
+	providerRepo ports.ProviderRepository
+	syncRunRepo  ports.SyncRunRepository
+}
+
+func NewListSyncRunsUseCase(
+	providerRepo ports.ProviderRepository,
+	syncRunRepo ports.SyncRunRepository,
+) *ListSyncRunsUseCase {
+	return &ListSyncRunsUseCase{
+		providerRepo: providerRepo,
+		syncRunRepo:  syncRunRepo,
+	}
+}
+
+func (uc *ListSyncRunsUseCase) Execute(ctx context.Context, req ListSyncRunsRequest) ([]SyncRunWithProvider, error) {
+	provider, err := uc.providerRepo.GetByCode(ctx, req.ProviderCode)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+	if provider == nil {
+		return nil, ErrProviderNotFound
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSyncRunLimit
+	}
+	if limit > maxSyncRunLimit {
+		limit = maxSyncRunLimit
+	}
+
+	runs, err := uc.syncRunRepo.ListByProvider(ctx, provider.ID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list sync runs: %w", err)
+	}
+
+	result := make([]SyncRunWithProvider, 0, len(runs))
+	for _, run := range runs {
+		result = append(result, SyncRunWithProvider{
+			Run:      run,
+			Provider: *provider,
+		})
+	}
+
+	return result, nil
+}
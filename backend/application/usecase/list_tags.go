@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+const (
+	defaultTagListLimit = 50
+	maxTagListLimit     = 500
+)
+
+type ListTagsRequest struct {
+	ContentType *entity.ContentType
+	Limit       int32
+}
+
+type ListTagsUseCase struct {
+	tagRepo ports.TagRepository
+}
+
+func NewListTagsUseCase(tagRepo ports.TagRepository) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+func (uc *ListTagsUseCase) Execute(ctx context.Context, req ListTagsRequest) ([]entity.TagCount, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultTagListLimit
+	}
+	if limit > maxTagListLimit {
+		limit = maxTagListLimit
+	}
+
+	tags, err := uc.tagRepo.ListWithCounts(ctx, req.ContentType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+	return tags, nil
+}
@@ -11,4 +11,15 @@ type MockLogger = mocks.MockLogger
 type MockProviderRepository = mocks.MockProviderRepository
 type MockTagRepository = mocks.MockTagRepository
 type MockProviderClient = mocks.MockProviderClient
-
+type ProviderClients = mocks.ProviderClients
+type MockCircuitBreakers = mocks.MockCircuitBreakers
+type MockSyncRunRepository = mocks.MockSyncRunRepository
+type MockContentRawPayloadRepository = mocks.MockContentRawPayloadRepository
+type MockFeedStateRepository = mocks.MockFeedStateRepository
+type MockTransactor = mocks.MockTransactor
+type MockLocker = mocks.MockLocker
+type MockLock = mocks.MockLock
+type MockContentScoreRepository = mocks.MockContentScoreRepository
+type MockSearchTermRepository = mocks.MockSearchTermRepository
+type MockScoringConfigProvider = mocks.MockScoringConfigProvider
+type MockMetadataRepository = mocks.MockMetadataRepository
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+const (
+	defaultPreviewItems = 5
+	maxPreviewItems     = 50
+)
+
+// errPreviewComplete stops a preview fetch after the feed's first page.
+var errPreviewComplete = errors.New("preview complete")
+
+type PreviewProviderMappingRequest struct {
+	// Provider needs its format, base URL, pagination and mapping, and its
+	// auth if the feed requires it.
+	Provider entity.Provider
+	Limit    int
+}
+
+type PreviewProviderMappingResult struct {
+	Items []ports.ProviderContentItem
+	// PageItemCount is the number of items on the feed's first page.
+	PageItemCount int
+}
+
+type PreviewProviderMappingUseCase struct {
+	providerClients ports.ProviderClientRegistry
+	feedSecrets     entity.FeedSecretsConfig
+}
+
+func NewPreviewProviderMappingUseCase(providerClients ports.ProviderClientRegistry, feedSecrets entity.FeedSecretsConfig) *PreviewProviderMappingUseCase {
+	return &PreviewProviderMappingUseCase{
+		providerClients: providerClients,
+		feedSecrets:     feedSecrets,
+	}
+}
+
+// Execute fetches the first page of a feed and maps its first items, so a
+// mapping can be checked before the provider is saved. Nothing is stored.
+func (uc *PreviewProviderMappingUseCase) Execute(ctx context.Context, req PreviewProviderMappingRequest) (*PreviewProviderMappingResult, error) {
+	provider := req.Provider
+	provider.Format = strings.ToLower(strings.TrimSpace(provider.Format))
+	provider.BaseURL = strings.TrimSpace(provider.BaseURL)
+
+	if err := validateBaseURL(provider.BaseURL); err != nil {
+		return nil, err
+	}
+	if err := service.ValidateFeedPagination(provider.Pagination); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+	if err := service.ValidateFeedAuth(provider.Auth, uc.feedSecrets); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+	if err := service.ValidateFeedMapping(provider.Format, provider.Mapping); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+	client, ok := uc.providerClients.Client(provider.Format)
+	if !ok {
+		return nil, fmt.Errorf("%w: format %q cannot be previewed", ErrInvalidProvider, provider.Format)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPreviewItems
+	}
+	if limit > maxPreviewItems {
+		limit = maxPreviewItems
+	}
+
+	result := &PreviewProviderMappingResult{Items: make([]ports.ProviderContentItem, 0, limit)}
+	_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, func(_ context.Context, items ports.ContentItems) error {
+		for item, err := range items {
+			if err != nil {
+				return err
+			}
+			result.PageItemCount++
+			if len(result.Items) < limit {
+				result.Items = append(result.Items, item)
+			}
+		}
+		return errPreviewComplete
+	})
+	if err != nil && !errors.Is(err, errPreviewComplete) {
+		return nil, fmt.Errorf("%w: %v", ErrProviderVerification, err)
+	}
+
+	return result, nil
+}
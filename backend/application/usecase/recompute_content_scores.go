@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+)
+
+const scoreRecomputeBatchSize = 500
+
+type RecomputeContentScoresUseCase struct {
+	scoreRepo      ports.ContentScoreRepository
+	configProvider ports.ScoringConfigProvider
+	scoringService *service.ScoringService
+	logger         ports.Logger
+}
+
+func NewRecomputeContentScoresUseCase(
+	scoreRepo ports.ContentScoreRepository,
+	configProvider ports.ScoringConfigProvider,
+	scoringService *service.ScoringService,
+	logger ports.Logger,
+) *RecomputeContentScoresUseCase {
+	return &RecomputeContentScoresUseCase{
+		scoreRepo:      scoreRepo,
+		configProvider: configProvider,
+		scoringService: scoringService,
+		logger:         logger,
+	}
+}
+
+// ReloadRules applies the current scoring rules to the scoring service and
+// reports whether they changed.
+func (uc *RecomputeContentScoresUseCase) ReloadRules() bool {
+	return uc.scoringService.UpdateConfig(uc.configProvider.GetScoringConfig())
+}
+
+// Execute recomputes the persisted score of every active content. Recency
+// decays with time, so this has to run periodically and not only on changes.
+func (uc *RecomputeContentScoresUseCase) Execute(ctx context.Context) (int, error) {
+	var afterID int64
+	total := 0
+
+	for {
+		items, err := uc.scoreRepo.ListForScoring(ctx, afterID, scoreRecomputeBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("list contents for scoring: %w", err)
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		computedAt := time.Now().UTC()
+		scores := make([]entity.ContentScore, 0, len(items))
+		for _, item := range items {
+			scores = append(scores, entity.ContentScore{
+				ContentID:  item.Content.ID,
+				Components: uc.scoringService.Calculate(item.Content, item.Stats),
+				ComputedAt: computedAt,
+			})
+		}
+
+		if err := uc.scoreRepo.SaveOrUpdateScores(ctx, scores); err != nil {
+			return total, fmt.Errorf("save content scores: %w", err)
+		}
+		total += len(scores)
+
+		if len(items) < scoreRecomputeBatchSize {
+			break
+		}
+		afterID = items[len(items)-1].Content.ID
+	}
+
+	uc.logger.Info("recomputed content scores", loggerPkg.Int("content_count", total))
+
+	return total, nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecomputeContentScoresUseCase_Execute(t *testing.T) {
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockConfigProvider := new(MockScoringConfigProvider)
+	mockLogger := new(MockLogger)
+
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	scoringService := service.NewScoringService(entity.ScoringConfig{
+		VideoTypeMultiplier: 1.0,
+		VideoViewsDivisor:   1.0,
+		VideoLikesDivisor:   1.0,
+	}, func() time.Time { return now })
+
+	uc := NewRecomputeContentScoresUseCase(mockScoreRepo, mockConfigProvider, scoringService, mockLogger)
+	ctx := context.Background()
+
+	t.Run("Recomputes Every Active Content", func(t *testing.T) {
+		items := []ports.ContentWithStats{
+			{
+				Content: entity.Content{ID: 5, ContentType: entity.ContentTypeVideo, PublishedAt: now.AddDate(-1, 0, 0)},
+				Stats:   entity.ContentStats{ContentID: 5, Views: 30, Likes: 3},
+			},
+		}
+		mockScoreRepo.On("ListForScoring", ctx, int64(0), int32(scoreRecomputeBatchSize)).Return(items, nil).Once()
+		mockScoreRepo.On("SaveOrUpdateScores", ctx, mock.MatchedBy(func(scores []entity.ContentScore) bool {
+			return len(scores) == 1 && scores[0].ContentID == 5 && scores[0].Components.FinalScore == 33
+		})).Return(nil).Once()
+		mockLogger.On("Info", "recomputed content scores", mock.Anything).Return().Once()
+
+		count, err := uc.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		mockScoreRepo.AssertExpectations(t)
+	})
+
+	t.Run("Reload Rules Reports Changes", func(t *testing.T) {
+		changed := entity.ScoringConfig{VideoTypeMultiplier: 2.0, VideoViewsDivisor: 1.0, VideoLikesDivisor: 1.0}
+		mockConfigProvider.On("GetScoringConfig").Return(changed).Twice()
+
+		assert.True(t, uc.ReloadRules())
+		assert.False(t, uc.ReloadRules())
+	})
+}
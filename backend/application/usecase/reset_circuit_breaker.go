@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type ResetCircuitBreakerUseCase struct {
+	providerRepo ports.ProviderRepository
+	breakers     ports.CircuitBreakers
+}
+
+func NewResetCircuitBreakerUseCase(providerRepo ports.ProviderRepository, breakers ports.CircuitBreakers) *ResetCircuitBreakerUseCase {
+	return &ResetCircuitBreakerUseCase{
+		providerRepo: providerRepo,
+		breakers:     breakers,
+	}
+}
+
+// Execute closes the provider's circuit breaker, so it is fetched again
+// without waiting out the breaker's timeout.
+func (uc *ResetCircuitBreakerUseCase) Execute(ctx context.Context, code string) (*ProviderCircuitBreaker, error) {
+	provider, err := uc.providerRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+	if provider == nil {
+		return nil, ErrProviderNotFound
+	}
+
+	uc.breakers.Reset(provider.Code)
+	return &ProviderCircuitBreaker{
+		Provider: *provider,
+		Breaker:  uc.breakers.State(provider.Code),
+	}, nil
+}
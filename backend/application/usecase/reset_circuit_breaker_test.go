@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListCircuitBreakersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockProviderRepo := new(MockProviderRepository)
+		mockBreakers := new(MockCircuitBreakers)
+		uc := NewListCircuitBreakersUseCase(mockProviderRepo, mockBreakers)
+
+		providers := []entity.Provider{{ID: 1, Code: "news"}, {ID: 2, Code: "videos"}}
+		open := ports.CircuitBreakerState{State: ports.CircuitBreakerOpen, Requests: 3, TotalFailures: 3, ConsecutiveFailures: 3}
+		closed := ports.CircuitBreakerState{State: ports.CircuitBreakerClosed}
+		mockProviderRepo.On("ListAll", ctx).Return(providers, nil)
+		mockBreakers.On("State", "news").Return(open)
+		mockBreakers.On("State", "videos").Return(closed)
+
+		breakers, err := uc.Execute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []ProviderCircuitBreaker{
+			{Provider: providers[0], Breaker: open},
+			{Provider: providers[1], Breaker: closed},
+		}, breakers)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockProviderRepo := new(MockProviderRepository)
+		uc := NewListCircuitBreakersUseCase(mockProviderRepo, new(MockCircuitBreakers))
+
+		mockProviderRepo.On("ListAll", ctx).Return([]entity.Provider(nil), errors.New("db down"))
+
+		_, err := uc.Execute(ctx)
+		assert.EqualError(t, err, "list providers: db down")
+	})
+}
+
+func TestResetCircuitBreakerUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mockProviderRepo := new(MockProviderRepository)
+		mockBreakers := new(MockCircuitBreakers)
+		uc := NewResetCircuitBreakerUseCase(mockProviderRepo, mockBreakers)
+
+		provider := &entity.Provider{ID: 1, Code: "news"}
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(provider, nil)
+		mockBreakers.On("Reset", "news").Return()
+		mockBreakers.On("State", "news").Return(ports.CircuitBreakerState{State: ports.CircuitBreakerClosed})
+
+		breaker, err := uc.Execute(ctx, "news")
+		assert.NoError(t, err)
+		assert.Equal(t, "news", breaker.Provider.Code)
+		assert.Equal(t, ports.CircuitBreakerClosed, breaker.Breaker.State)
+		mockBreakers.AssertCalled(t, "Reset", "news")
+	})
+
+	t.Run("Provider Not Found", func(t *testing.T) {
+		mockProviderRepo := new(MockProviderRepository)
+		mockBreakers := new(MockCircuitBreakers)
+		uc := NewResetCircuitBreakerUseCase(mockProviderRepo, mockBreakers)
+
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil)
+
+		_, err := uc.Execute(ctx, "missing")
+		assert.ErrorIs(t, err, ErrProviderNotFound)
+		mockBreakers.AssertNotCalled(t, "Reset", mock.Anything)
+	})
+}
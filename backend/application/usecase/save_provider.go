@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+var (
+	ErrProviderExists       = errors.New("provider already exists")
+	ErrInvalidProvider      = errors.New("invalid provider")
+	ErrProviderVerification = errors.New("provider verification failed")
+)
+
+var providerCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+type SaveProviderRequest struct {
+	Provider entity.Provider
+	// Create rejects existing codes; otherwise the provider must exist and
+	// keeps its enabled state.
+	Create bool
+	// Keep names the settings an update leaves as stored instead of taking
+	// them from Provider.
+	Keep ProviderFields
+	// Verify fetches and parses the provider feed before saving.
+	Verify bool
+}
+
+// ProviderFields selects the settings of a provider.
+type ProviderFields struct {
+	Name       bool
+	Format     bool
+	BaseURL    bool
+	Schedule   bool
+	Pagination bool
+	Mapping    bool
+	Auth       bool
+}
+
+type SaveProviderResult struct {
+	Provider entity.Provider
+	// VerifiedItems is the number of items fetched when verifying.
+	VerifiedItems int
+}
+
+type SaveProviderUseCase struct {
+	providerRepo    ports.ProviderRepository
+	metadataRepo    ports.MetadataRepository
+	providerClients ports.ProviderClientRegistry
+	feedSecrets     entity.FeedSecretsConfig
+}
+
+func NewSaveProviderUseCase(
+	providerRepo ports.ProviderRepository,
+	metadataRepo ports.MetadataRepository,
+	providerClients ports.ProviderClientRegistry,
+	feedSecrets entity.FeedSecretsConfig,
+) *SaveProviderUseCase {
+	return &SaveProviderUseCase{
+		providerRepo:    providerRepo,
+		metadataRepo:    metadataRepo,
+		providerClients: providerClients,
+		feedSecrets:     feedSecrets,
+	}
+}
+
+func (uc *SaveProviderUseCase) Execute(ctx context.Context, req SaveProviderRequest) (*SaveProviderResult, error) {
+	provider := req.Provider
+	provider.Code = strings.TrimSpace(provider.Code)
+	provider.Name = strings.TrimSpace(provider.Name)
+	provider.Format = strings.ToLower(strings.TrimSpace(provider.Format))
+	provider.BaseURL = strings.TrimSpace(provider.BaseURL)
+
+	if !req.Create {
+		existing, err := uc.providerRepo.GetByCode(ctx, provider.Code)
+		if err != nil {
+			return nil, fmt.Errorf("get provider: %w", err)
+		}
+		if existing == nil {
+			return nil, ErrProviderNotFound
+		}
+		provider = keepProviderFields(provider, *existing, req.Keep)
+		provider.ID = existing.ID
+		provider.IsEnabled = existing.IsEnabled
+	}
+
+	if err := uc.validate(ctx, provider); err != nil {
+		return nil, err
+	}
+
+	if req.Create {
+		existing, err := uc.providerRepo.GetByCode(ctx, provider.Code)
+		if err != nil {
+			return nil, fmt.Errorf("get provider: %w", err)
+		}
+		if existing != nil {
+			return nil, ErrProviderExists
+		}
+	}
+
+	result := &SaveProviderResult{}
+	if req.Verify {
+		client, ok := uc.providerClients.Client(provider.Format)
+		if !ok {
+			return nil, fmt.Errorf("%w: no client registered for format %q", ErrProviderVerification, provider.Format)
+		}
+		// The feed is fetched as an unsaved provider, without a code, so it is
+		// fetched once and a candidate that fails counts against neither the
+		// stored provider's circuit breaker nor any retry budget.
+		candidate := provider
+		candidate.Code = ""
+		fetched, err := client.FetchContents(ctx, candidate, entity.FeedValidators{}, func(context.Context, ports.ContentItems) error {
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrProviderVerification, err)
+		}
+		result.VerifiedItems = fetched.ItemCount
+	}
+
+	if err := uc.providerRepo.UpsertProvider(ctx, provider); err != nil {
+		return nil, fmt.Errorf("save provider: %w", err)
+	}
+
+	saved, err := uc.providerRepo.GetByCode(ctx, provider.Code)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+	if saved == nil {
+		return nil, ErrProviderNotFound
+	}
+	result.Provider = *saved
+
+	return result, nil
+}
+
+// keepProviderFields returns provider with the settings selected by keep
+// taken from stored.
+func keepProviderFields(provider, stored entity.Provider, keep ProviderFields) entity.Provider {
+	if keep.Name {
+		provider.Name = stored.Name
+	}
+	if keep.Format {
+		provider.Format = stored.Format
+	}
+	if keep.BaseURL {
+		provider.BaseURL = stored.BaseURL
+	}
+	if keep.Schedule {
+		provider.Schedule = stored.Schedule
+	}
+	if keep.Pagination {
+		provider.Pagination = stored.Pagination
+	}
+	if keep.Mapping {
+		provider.Mapping = stored.Mapping
+	}
+	if keep.Auth {
+		provider.Auth = stored.Auth
+	}
+	return provider
+}
+
+func (uc *SaveProviderUseCase) validate(ctx context.Context, provider entity.Provider) error {
+	if !providerCodePattern.MatchString(provider.Code) {
+		return fmt.Errorf("%w: code must be lowercase letters, digits, '-' or '_'", ErrInvalidProvider)
+	}
+	if provider.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidProvider)
+	}
+
+	if err := validateBaseURL(provider.BaseURL); err != nil {
+		return err
+	}
+
+	if err := service.ValidateSyncSchedule(provider.Schedule); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+	if err := service.ValidateFeedPagination(provider.Pagination); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+	if err := service.ValidateFeedAuth(provider.Auth, uc.feedSecrets); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+
+	formats, err := uc.metadataRepo.GetProviderFormats(ctx)
+	if err != nil {
+		return fmt.Errorf("get provider formats: %w", err)
+	}
+	if !slices.Contains(formats, provider.Format) {
+		return fmt.Errorf("%w: format must be one of %s", ErrInvalidProvider, strings.Join(formats, ", "))
+	}
+	if err := service.ValidateFeedMapping(provider.Format, provider.Mapping); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidProvider, err)
+	}
+
+	return nil
+}
+
+func validateBaseURL(rawURL string) error {
+	baseURL, err := url.Parse(rawURL)
+	if err != nil || (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return fmt.Errorf("%w: base_url must be an absolute http(s) URL", ErrInvalidProvider)
+	}
+	return nil
+}
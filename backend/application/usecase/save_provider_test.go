@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSaveProviderUseCase_Execute(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockMetadataRepo := new(MockMetadataRepository)
+	mockJSONClient := new(MockProviderClient)
+	mockXMLClient := new(MockProviderClient)
+
+	uc := NewSaveProviderUseCase(mockProviderRepo, mockMetadataRepo, ProviderClients{entity.ProviderFormatJSON: mockJSONClient, entity.ProviderFormatXML: mockXMLClient}, entity.FeedSecretsConfig{})
+	ctx := context.Background()
+
+	mockMetadataRepo.On("GetProviderFormats", ctx).Return([]string{"json", "xml"}, nil)
+
+	valid := entity.Provider{
+		Code:      "news",
+		Name:      "News",
+		Format:    "JSON",
+		BaseURL:   "https://example.com/feed",
+		IsEnabled: true,
+	}
+
+	t.Run("Rejects Unknown Format", func(t *testing.T) {
+		provider := valid
+		provider.Format = "yaml"
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: provider, Create: true})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+		assert.ErrorContains(t, err, "json, xml")
+	})
+
+	t.Run("Rejects Relative URL", func(t *testing.T) {
+		provider := valid
+		provider.BaseURL = "/feed"
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: provider, Create: true})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+	})
+
+	t.Run("Rejects Invalid Cron", func(t *testing.T) {
+		provider := valid
+		provider.Schedule = entity.SyncSchedule{Cron: "every minute"}
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: provider, Create: true})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+	})
+
+	t.Run("Rejects Invalid Mapping", func(t *testing.T) {
+		provider := valid
+		provider.Mapping = entity.FeedMapping{Items: "$.items", ID: entity.FieldMapping{Path: "$.id"}}
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: provider, Create: true})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+		assert.ErrorContains(t, err, "id and title paths")
+	})
+
+	t.Run("Rejects Plaintext Secrets", func(t *testing.T) {
+		provider := valid
+		provider.Auth = entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "s3cr3t-token"}
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: provider, Create: true})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+		assert.ErrorContains(t, err, "env:NAME or file:/path")
+	})
+
+	t.Run("Create Rejects Existing Code", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&entity.Provider{ID: 1, Code: "news"}, nil).Once()
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: valid, Create: true})
+		assert.ErrorIs(t, err, ErrProviderExists)
+	})
+
+	t.Run("Verification Failure Does Not Save", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(nil, nil).Once()
+		mockJSONClient.On("FetchContents", ctx, mock.Anything, entity.FeedValidators{}).Return(nil, nil, errors.New("unexpected EOF")).Once()
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: valid, Create: true, Verify: true})
+		assert.ErrorIs(t, err, ErrProviderVerification)
+		mockProviderRepo.AssertNotCalled(t, "UpsertProvider", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Update Keeps Enabled State", func(t *testing.T) {
+		existing := &entity.Provider{ID: 4, Code: "news", Name: "Old", Format: "xml", BaseURL: "https://old.example.com", IsEnabled: false}
+		updated := entity.Provider{ID: 4, Code: "news", Name: "News", Format: "json", BaseURL: "https://example.com/feed", IsEnabled: false}
+
+		verified := updated
+		verified.Code = ""
+
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(existing, nil).Once()
+		mockJSONClient.On("FetchContents", ctx, verified, entity.FeedValidators{}).Return(fetchedPages([]ports.ProviderContentItem{{ProviderContentID: "a"}, {ProviderContentID: "b"}})...).Once()
+		mockProviderRepo.On("UpsertProvider", ctx, updated).Return(nil).Once()
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&updated, nil).Once()
+
+		result, err := uc.Execute(ctx, SaveProviderRequest{Provider: valid, Verify: true})
+		assert.NoError(t, err)
+		assert.Equal(t, updated, result.Provider)
+		assert.Equal(t, 2, result.VerifiedItems)
+		mockProviderRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Keeps Unset Settings", func(t *testing.T) {
+		existing := &entity.Provider{
+			ID:         5,
+			Code:       "news",
+			Name:       "Old",
+			Format:     "xml",
+			BaseURL:    "https://old.example.com",
+			IsEnabled:  true,
+			Schedule:   entity.SyncSchedule{Cron: "*/5 * * * *"},
+			Pagination: entity.FeedPagination{Strategy: entity.PaginationLink},
+			Mapping:    entity.FeedMapping{Items: "/feed/entry", ID: entity.FieldMapping{Path: "id"}, Title: entity.FieldMapping{Path: "title"}},
+			Auth:       entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:FEED_SECRET_NEWS"},
+		}
+		updated := *existing
+		updated.Name = "News"
+
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(existing, nil).Once()
+		mockProviderRepo.On("UpsertProvider", ctx, updated).Return(nil).Once()
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&updated, nil).Once()
+
+		result, err := uc.Execute(ctx, SaveProviderRequest{
+			Provider: entity.Provider{Code: "news", Name: "News"},
+			Keep:     ProviderFields{Format: true, BaseURL: true, Schedule: true, Pagination: true, Mapping: true, Auth: true},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, updated, result.Provider)
+		mockProviderRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(nil, nil).Once()
+
+		_, err := uc.Execute(ctx, SaveProviderRequest{Provider: valid})
+		assert.ErrorIs(t, err, ErrProviderNotFound)
+	})
+}
+
+func TestPreviewProviderMappingUseCase_Execute(t *testing.T) {
+	mockJSONClient := new(MockProviderClient)
+	mockXMLClient := new(MockProviderClient)
+
+	uc := NewPreviewProviderMappingUseCase(ProviderClients{entity.ProviderFormatJSON: mockJSONClient, entity.ProviderFormatXML: mockXMLClient}, entity.FeedSecretsConfig{})
+	ctx := context.Background()
+
+	provider := entity.Provider{
+		Format:  "json",
+		BaseURL: "https://example.com/feed",
+		Mapping: entity.FeedMapping{
+			Items: "$.results",
+			ID:    entity.FieldMapping{Path: "$.uid"},
+			Title: entity.FieldMapping{Path: "$.headline"},
+		},
+	}
+
+	t.Run("Maps The First Page Up To The Limit", func(t *testing.T) {
+		first := []ports.ProviderContentItem{{ProviderContentID: "a"}, {ProviderContentID: "b"}, {ProviderContentID: "c"}}
+		second := []ports.ProviderContentItem{{ProviderContentID: "d"}}
+		mockJSONClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return(fetchedPages(first, second)...).Once()
+
+		result, err := uc.Execute(ctx, PreviewProviderMappingRequest{Provider: provider, Limit: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, first[:2], result.Items)
+		assert.Equal(t, 3, result.PageItemCount)
+	})
+
+	t.Run("Rejects Invalid Mapping", func(t *testing.T) {
+		invalid := provider
+		invalid.Mapping.Items = "$..results"
+
+		_, err := uc.Execute(ctx, PreviewProviderMappingRequest{Provider: invalid})
+		assert.ErrorIs(t, err, ErrInvalidProvider)
+	})
+
+	t.Run("Fetch Failure", func(t *testing.T) {
+		mockJSONClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return(nil, nil, errors.New("page 1: map item 1: id is empty")).Once()
+
+		_, err := uc.Execute(ctx, PreviewProviderMappingRequest{Provider: provider})
+		assert.ErrorIs(t, err, ErrProviderVerification)
+		assert.ErrorContains(t, err, "id is empty")
+	})
+}
+
+func TestDeleteProviderUseCase_Execute(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	uc := NewDeleteProviderUseCase(mockProviderRepo)
+	ctx := context.Background()
+
+	t.Run("Provider With Contents", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&entity.Provider{ID: 3, Code: "news"}, nil).Once()
+		mockProviderRepo.On("HasContents", ctx, int64(3)).Return(true, nil).Once()
+
+		err := uc.Execute(ctx, "news")
+		assert.ErrorIs(t, err, ErrProviderInUse)
+	})
+
+	t.Run("Unused Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "draft").Return(&entity.Provider{ID: 5, Code: "draft"}, nil).Once()
+		mockProviderRepo.On("HasContents", ctx, int64(5)).Return(false, nil).Once()
+		mockProviderRepo.On("Delete", ctx, int64(5)).Return(nil).Once()
+
+		assert.NoError(t, uc.Execute(ctx, "draft"))
+		mockProviderRepo.AssertExpectations(t)
+	})
+}
@@ -3,7 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
-	"sort"
+	"strings"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -16,6 +16,10 @@ type ContentWithScore struct {
 	Content entity.Content
 	Stats   entity.ContentStats
 	Score   entity.ScoreComponents
+	Tags    []entity.Tag
+	// Provider is resolved on every request rather than cached with the
+	// results, so provider changes show up immediately.
+	Provider *entity.Provider
 }
 
 type SearchResult struct {
@@ -23,66 +27,125 @@ type SearchResult struct {
 	Page     int32
 	PageSize int32
 	Total    int64
+	// Suggestions are corrected queries, offered when few contents match.
+	Suggestions []string
+	// Facets is set only when requested.
+	Facets *entity.SearchFacets
 }
 
 type SortOption string
 
 const (
-	SortScoreDesc   SortOption = "score_desc"
-	SortScoreAsc    SortOption = "score_asc"
-	SortDateDesc    SortOption = "date_desc"
-	SortDateAsc     SortOption = "date_asc"
+	SortScoreDesc SortOption = "score_desc"
+	SortScoreAsc  SortOption = "score_asc"
+	SortDateDesc  SortOption = "date_desc"
+	SortDateAsc   SortOption = "date_asc"
 	// Legacy support
 	SortRecencyDesc SortOption = "recency_desc"
 )
 
+type TagMatchMode string
+
+const (
+	TagMatchAny TagMatchMode = "any"
+	TagMatchAll TagMatchMode = "all"
+)
+
 type SearchContentsRequest struct {
 	Query       string
 	ContentType *entity.ContentType
-	Sort        SortOption
-	Page        int32
-	PageSize    int32
+	IncludeTags []string
+	ExcludeTags []string
+	// ProviderCodes keeps contents from any of these providers.
+	ProviderCodes []string
+	// TagMatch decides whether contents need any or all of IncludeTags.
+	TagMatch        TagMatchMode
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+	Sort            SortOption
+	Page            int32
+	PageSize        int32
+	// IncludeFacets adds counts per content type, provider, tag and publish
+	// date over all contents matching the filters.
+	IncludeFacets bool
 }
 
 type SearchContentsUseCase struct {
 	contentRepo      ports.ContentRepository
 	contentStatsRepo ports.ContentStatsRepository
+	scoreRepo        ports.ContentScoreRepository
+	searchTermRepo   ports.SearchTermRepository
+	tagRepo          ports.TagRepository
+	providerRepo     ports.ProviderRepository
 	cacheClient      ports.CacheClient
 	scoringService   *service.ScoringService
+	tagNormalizer    *service.TagNormalizer
 	logger           ports.Logger
 	cacheTTL         time.Duration
+	searchConfig     entity.SearchConfig
 }
 
 func NewSearchContentsUseCase(
 	contentRepo ports.ContentRepository,
 	contentStatsRepo ports.ContentStatsRepository,
+	scoreRepo ports.ContentScoreRepository,
+	searchTermRepo ports.SearchTermRepository,
+	tagRepo ports.TagRepository,
+	providerRepo ports.ProviderRepository,
 	cacheClient ports.CacheClient,
 	scoringService *service.ScoringService,
+	tagNormalizer *service.TagNormalizer,
 	logger ports.Logger,
 	cacheTTL time.Duration,
+	searchConfig entity.SearchConfig,
 ) *SearchContentsUseCase {
 	return &SearchContentsUseCase{
 		contentRepo:      contentRepo,
 		contentStatsRepo: contentStatsRepo,
+		scoreRepo:        scoreRepo,
+		searchTermRepo:   searchTermRepo,
+		tagRepo:          tagRepo,
+		providerRepo:     providerRepo,
 		cacheClient:      cacheClient,
 		scoringService:   scoringService,
+		tagNormalizer:    tagNormalizer,
 		logger:           logger,
 		cacheTTL:         cacheTTL,
+		searchConfig:     searchConfig,
 	}
 }
 
 func (uc *SearchContentsUseCase) Execute(ctx context.Context, req SearchContentsRequest) (*SearchResult, error) {
+	req.IncludeTags = uc.tagNormalizer.Normalize(req.IncludeTags)
+	req.ExcludeTags = uc.tagNormalizer.Normalize(req.ExcludeTags)
 	cacheKey := uc.buildCacheKey(req)
-	
+
 	var cachedResult SearchResult
 	found, err := uc.cacheClient.Get(ctx, cacheKey, &cachedResult)
 	if err == nil && found {
+		if err := attachProviders(ctx, uc.providerRepo, cachedResult.Items); err != nil {
+			return nil, err
+		}
 		return &cachedResult, nil
 	}
 
+	providerIDs, err := uc.providerIDs(ctx, req.ProviderCodes)
+	if err != nil {
+		return nil, err
+	}
+
 	filters := ports.SearchFilters{
-		Query:       req.Query,
-		ContentType: req.ContentType,
+		Query:           req.Query,
+		FullTextQuery:   service.ParseSearchQuery(req.Query),
+		ContentType:     req.ContentType,
+		IncludeTags:     req.IncludeTags,
+		MatchAllTags:    req.TagMatch == TagMatchAll,
+		ExcludeTags:     req.ExcludeTags,
+		PublishedAfter:  req.PublishedAfter,
+		PublishedBefore: req.PublishedBefore,
+		ProviderIDs:     providerIDs,
+		Sort:            sortOrderFor(req.Sort),
+		RelevanceWeight: uc.scoringService.RelevanceWeight(),
 	}
 
 	pagination := ports.Pagination{
@@ -90,24 +153,54 @@ func (uc *SearchContentsUseCase) Execute(ctx context.Context, req SearchContents
 		PageSize: req.PageSize,
 	}
 
-	contents, total, err := uc.contentRepo.SearchContents(ctx, filters, pagination)
+	matches, total, err := uc.contentRepo.SearchContents(ctx, filters, pagination)
 	if err != nil {
 		return nil, fmt.Errorf("search contents: %w", err)
 	}
 
-	if len(contents) == 0 {
+	// Full-text search only matches whole, correctly spelled stems; retry
+	// with substring and trigram matching so partial or misspelled words
+	// still find something.
+	if total == 0 && req.Query != "" {
+		filters.FullTextQuery = ""
+		filters.Fuzzy = true
+		matches, total, err = uc.contentRepo.SearchContents(ctx, filters, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("search contents: %w", err)
+		}
+	}
+
+	var suggestions []string
+	if total < uc.searchConfig.GetSuggestBelowResults() && req.Query != "" {
+		suggestions = uc.suggest(ctx, req.Query)
+	}
+
+	// Facets use the filters the page was found with, so they follow the
+	// fuzzy fallback above.
+	var facets *entity.SearchFacets
+	if req.IncludeFacets {
+		counts, err := uc.contentRepo.SearchFacets(ctx, filters, uc.searchConfig.GetFacetTagLimit())
+		if err != nil {
+			return nil, fmt.Errorf("search facets: %w", err)
+		}
+		facets = &counts
+	}
+
+	if len(matches) == 0 {
 		result := &SearchResult{
-			Items:    []ContentWithScore{},
-			Page:     req.Page,
-			PageSize: req.PageSize,
-			Total:    0,
+			Items:       []ContentWithScore{},
+			Page:        req.Page,
+			PageSize:    req.PageSize,
+			Total:       0,
+			Suggestions: suggestions,
+			Facets:      facets,
 		}
 		return result, nil
 	}
 
-	contentIDs := make([]int64, len(contents))
-	for i, content := range contents {
-		contentIDs[i] = content.ID
+	contentIDs := make([]int64, len(matches))
+	for i, match := range matches {
+		contentIDs[i] = match.Content.ID
 	}
 
 	statsMap, err := uc.contentStatsRepo.GetByContentIDs(ctx, contentIDs)
@@ -115,60 +208,118 @@ func (uc *SearchContentsUseCase) Execute(ctx context.Context, req SearchContents
 		return nil, fmt.Errorf("get content stats: %w", err)
 	}
 
-	items := make([]ContentWithScore, 0, len(contents))
-	for _, content := range contents {
+	scoreMap, err := uc.scoreRepo.GetByContentIDs(ctx, contentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get content scores: %w", err)
+	}
+
+	tagMap, err := uc.tagRepo.GetByContentIDs(ctx, contentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get content tags: %w", err)
+	}
+
+	items := make([]ContentWithScore, 0, len(matches))
+	for _, match := range matches {
+		content := match.Content
 		stats, ok := statsMap[content.ID]
 		if !ok {
 			stats = entity.ContentStats{ContentID: content.ID}
 		}
 
+		// The persisted score is what the page was ordered by; fall back to
+		// computing it for contents that have not been scored yet.
 		score := uc.scoringService.Calculate(content, stats)
+		if persisted, ok := scoreMap[content.ID]; ok {
+			score = persisted.Components
+		}
+		score = uc.scoringService.WithRelevance(score, match.Relevance)
 
 		items = append(items, ContentWithScore{
 			Content: content,
 			Stats:   stats,
 			Score:   score,
+			Tags:    tagMap[content.ID],
 		})
 	}
 
-	uc.sortItems(items, req.Sort)
-
 	result := &SearchResult{
-		Items:    items,
-		Page:     req.Page,
-		PageSize: req.PageSize,
-		Total:    total,
+		Items:       items,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Total:       total,
+		Suggestions: suggestions,
+		Facets:      facets,
 	}
 
 	if err := uc.cacheClient.Set(ctx, cacheKey, result, uc.cacheTTL); err != nil {
 		uc.logger.Warn("failed to cache search result", loggerPkg.String("error", err.Error()))
 	}
 
+	if err := attachProviders(ctx, uc.providerRepo, result.Items); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
-func (uc *SearchContentsUseCase) sortItems(items []ContentWithScore, sortOption SortOption) {
+func (uc *SearchContentsUseCase) providerIDs(ctx context.Context, codes []string) ([]int64, error) {
+	ids := make([]int64, 0, len(codes))
+	for _, code := range codes {
+		provider, err := uc.providerRepo.GetByCode(ctx, code)
+		if err != nil {
+			return nil, fmt.Errorf("get provider: %w", err)
+		}
+		if provider == nil {
+			return nil, fmt.Errorf("%w: %s", ErrProviderNotFound, code)
+		}
+		ids = append(ids, provider.ID)
+	}
+	return ids, nil
+}
+
+func attachProviders(ctx context.Context, providerRepo ports.ProviderRepository, items []ContentWithScore) error {
+	for i := range items {
+		provider, err := providerRepo.GetByID(ctx, items[i].Content.ProviderID)
+		if err != nil {
+			return fmt.Errorf("get provider: %w", err)
+		}
+		items[i].Provider = provider
+	}
+	return nil
+}
+
+// suggest proposes corrected queries from known title words and tag names.
+// Suggestions are best effort, so lookup failures only drop them.
+func (uc *SearchContentsUseCase) suggest(ctx context.Context, query string) []string {
+	terms := service.QueryTerms(query)
+	limit := uc.searchConfig.GetSuggestionLimit()
+
+	candidates := make(map[string][]string, len(terms))
+	for _, term := range terms {
+		if _, ok := candidates[term]; ok {
+			continue
+		}
+		similar, err := uc.searchTermRepo.SuggestTerms(ctx, term, int32(limit))
+		if err != nil {
+			uc.logger.Warn("failed to suggest search terms", loggerPkg.String("error", err.Error()))
+			return nil
+		}
+		candidates[term] = similar
+	}
+
+	return service.BuildSuggestions(terms, candidates, limit)
+}
+
+func sortOrderFor(sortOption SortOption) ports.SortOrder {
 	switch sortOption {
-	case SortScoreDesc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].Score.FinalScore > items[j].Score.FinalScore
-		})
 	case SortScoreAsc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].Score.FinalScore < items[j].Score.FinalScore
-		})
+		return ports.SortByScoreAsc
 	case SortDateDesc, SortRecencyDesc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].Content.PublishedAt.After(items[j].Content.PublishedAt)
-		})
+		return ports.SortByDateDesc
 	case SortDateAsc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].Content.PublishedAt.Before(items[j].Content.PublishedAt)
-		})
+		return ports.SortByDateAsc
 	default:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].Score.FinalScore > items[j].Score.FinalScore
-		})
+		return ports.SortByScoreDesc
 	}
 }
 
@@ -177,6 +328,16 @@ func (uc *SearchContentsUseCase) buildCacheKey(req SearchContentsRequest) string
 	if req.ContentType != nil {
 		typeStr = string(*req.ContentType)
 	}
-	return fmt.Sprintf("search:%s:%s:%s:%d:%d",
-		req.Query, typeStr, req.Sort, req.Page, req.PageSize)
+	return fmt.Sprintf("search:%s:%s:%s:%d:%d:%s:%s:%s:%s:%s:%s:%t",
+		req.Query, typeStr, req.Sort, req.Page, req.PageSize,
+		strings.Join(req.IncludeTags, ","), req.TagMatch, strings.Join(req.ExcludeTags, ","),
+		strings.Join(req.ProviderCodes, ","),
+		formatTimeKey(req.PublishedAfter), formatTimeKey(req.PublishedBefore), req.IncludeFacets)
+}
+
+func formatTimeKey(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
 }
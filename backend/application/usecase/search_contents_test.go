@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +16,10 @@ import (
 func TestSearchContentsUseCase_Execute(t *testing.T) {
 	mockContentRepo := new(MockContentRepository)
 	mockStatsRepo := new(MockContentStatsRepository)
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockSearchTermRepo := new(MockSearchTermRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockProviderRepo := new(MockProviderRepository)
 	mockCache := new(MockCacheClient)
 	mockLogger := new(MockLogger)
 
@@ -21,6 +27,7 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 		VideoViewsDivisor:   1.0,
 		VideoLikesDivisor:   1.0,
 		VideoTypeMultiplier: 1.0,
+		RelevanceWeight:     10.0,
 	}
 	timeProvider := func() time.Time { return time.Now() }
 	scoringService := service.NewScoringService(scoringConfig, timeProvider)
@@ -28,10 +35,16 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 	uc := NewSearchContentsUseCase(
 		mockContentRepo,
 		mockStatsRepo,
+		mockScoreRepo,
+		mockSearchTermRepo,
+		mockTagRepo,
+		mockProviderRepo,
 		mockCache,
 		scoringService,
+		service.NewTagNormalizer(),
 		mockLogger,
 		time.Minute,
+		entity.SearchConfig{SuggestBelowResults: 1},
 	)
 
 	ctx := context.Background()
@@ -42,6 +55,9 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 		Sort:     SortScoreDesc,
 	}
 
+	provider := &entity.Provider{ID: 1, Code: "provider1", Name: "Provider One", Format: entity.ProviderFormatJSON}
+	mockProviderRepo.On("GetByID", ctx, int64(0)).Return(provider, nil)
+
 	t.Run("Cache Hit", func(t *testing.T) {
 		cachedResult := SearchResult{Total: 100}
 		mockCache.On("Get", ctx, mock.AnythingOfType("string"), mock.Anything).Return(true, nil).Run(func(args mock.Arguments) {
@@ -58,17 +74,30 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 	t.Run("Cache Miss - Success", func(t *testing.T) {
 		mockCache.On("Get", ctx, mock.AnythingOfType("string"), mock.Anything).Return(false, nil)
 
-		contents := []entity.Content{
-			{ID: 1, ContentType: entity.ContentTypeVideo, Title: "Video 1"},
-			{ID: 2, ContentType: entity.ContentTypeVideo, Title: "Video 2"},
+		// Ordering happens in SQL, so the repository returns the page already sorted.
+		matches := []ports.ContentMatch{
+			{Content: entity.Content{ID: 2, ContentType: entity.ContentTypeVideo, Title: "Video 2"}, Relevance: 0.5},
+			{Content: entity.Content{ID: 1, ContentType: entity.ContentTypeVideo, Title: "Video 1"}},
 		}
-		mockContentRepo.On("SearchContents", ctx, mock.Anything, mock.Anything).Return(contents, int64(2), nil)
+		mockContentRepo.On("SearchContents", ctx, mock.MatchedBy(func(filters ports.SearchFilters) bool {
+			return filters.Query == "test" && filters.FullTextQuery == "test" &&
+				filters.Sort == ports.SortByScoreDesc && filters.RelevanceWeight == 10.0
+		}), mock.Anything).Return(matches, int64(2), nil).Once()
 
 		stats := map[int64]entity.ContentStats{
 			1: {ContentID: 1, Views: 100, Likes: 10},
 			2: {ContentID: 2, Views: 200, Likes: 20},
 		}
-		mockStatsRepo.On("GetByContentIDs", ctx, []int64{1, 2}).Return(stats, nil)
+		mockStatsRepo.On("GetByContentIDs", ctx, []int64{2, 1}).Return(stats, nil)
+
+		// Content 1 has not been scored yet and falls back to an in-memory score.
+		scores := map[int64]entity.ContentScore{
+			2: {ContentID: 2, Components: entity.ScoreComponents{FinalScore: 42}},
+		}
+		mockScoreRepo.On("GetByContentIDs", ctx, []int64{2, 1}).Return(scores, nil)
+		mockTagRepo.On("GetByContentIDs", ctx, []int64{2, 1}).Return(map[int64][]entity.Tag{
+			2: {{ID: 5, Name: "music"}},
+		}, nil)
 
 		mockCache.On("Set", ctx, mock.AnythingOfType("string"), mock.Anything, time.Minute).Return(nil)
 
@@ -76,10 +105,153 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(2), res.Total)
 		assert.Len(t, res.Items, 2)
-		
-		// Verify sorting (Score Desc)
-		// Video 2 should have higher score (200 views > 100 views)
+
 		assert.Equal(t, int64(2), res.Items[0].Content.ID)
+		assert.Equal(t, 5.0, res.Items[0].Score.RelevanceScore)
+		assert.Equal(t, 47.0, res.Items[0].Score.FinalScore)
 		assert.Equal(t, int64(1), res.Items[1].Content.ID)
+		assert.Equal(t, 110.0, res.Items[1].Score.FinalScore)
+		assert.Equal(t, []entity.Tag{{ID: 5, Name: "music"}}, res.Items[0].Tags)
+		assert.Empty(t, res.Items[1].Tags)
+		assert.Equal(t, provider, res.Items[0].Provider)
+	})
+
+	t.Run("Provider Filter", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "provider1").Return(provider, nil).Once()
+		mockContentRepo.On("SearchContents", ctx, mock.MatchedBy(func(filters ports.SearchFilters) bool {
+			return assert.ObjectsAreEqual([]int64{1}, filters.ProviderIDs)
+		}), mock.Anything).Return([]ports.ContentMatch{}, int64(0), nil).Once()
+
+		_, err := uc.Execute(ctx, SearchContentsRequest{ProviderCodes: []string{"provider1"}, Page: 1, PageSize: 10})
+		assert.NoError(t, err)
+		mockContentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := uc.Execute(ctx, SearchContentsRequest{ProviderCodes: []string{"missing"}, Page: 1, PageSize: 10})
+		assert.ErrorIs(t, err, ErrProviderNotFound)
+	})
+
+	t.Run("Tag Filters", func(t *testing.T) {
+		mockContentRepo.On("SearchContents", ctx, mock.MatchedBy(func(filters ports.SearchFilters) bool {
+			return assert.ObjectsAreEqual([]string{"go", "testing"}, filters.IncludeTags) &&
+				filters.MatchAllTags &&
+				assert.ObjectsAreEqual([]string{"java"}, filters.ExcludeTags)
+		}), mock.Anything).Return([]ports.ContentMatch{}, int64(0), nil).Once()
+
+		res, err := uc.Execute(ctx, SearchContentsRequest{
+			IncludeTags: []string{" Go", "testing", "go"},
+			ExcludeTags: []string{"JAVA"},
+			TagMatch:    TagMatchAll,
+			Page:        1,
+			PageSize:    10,
+		})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Items)
+		mockContentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Facets And Date Filter", func(t *testing.T) {
+		after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		facets := entity.SearchFacets{
+			ContentTypes: []entity.FacetValue{{Value: "video", Label: "video", Count: 42}},
+			PublishedAt:  []entity.FacetValue{{Value: entity.PublishedPastDay, Label: entity.PublishedPastDay, Count: 3}},
+		}
+		dateFiltered := mock.MatchedBy(func(filters ports.SearchFilters) bool {
+			return filters.PublishedAfter != nil && filters.PublishedAfter.Equal(after)
+		})
+		mockContentRepo.On("SearchContents", ctx, dateFiltered, mock.Anything).Return([]ports.ContentMatch{}, int64(0), nil).Once()
+		mockContentRepo.On("SearchFacets", ctx, dateFiltered, int32(10)).Return(facets, nil).Once()
+
+		res, err := uc.Execute(ctx, SearchContentsRequest{
+			PublishedAfter: &after,
+			IncludeFacets:  true,
+			Page:           1,
+			PageSize:       10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &facets, res.Facets)
+		mockContentRepo.AssertExpectations(t)
+	})
+}
+
+func TestSearchContentsUseCase_Execute_FallsBackToFuzzyMatch(t *testing.T) {
+	mockContentRepo := new(MockContentRepository)
+	mockStatsRepo := new(MockContentStatsRepository)
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockSearchTermRepo := new(MockSearchTermRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockCache := new(MockCacheClient)
+	mockLogger := new(MockLogger)
+
+	scoringService := service.NewScoringService(entity.ScoringConfig{}, time.Now)
+	mockProviderRepo := new(MockProviderRepository)
+	uc := NewSearchContentsUseCase(mockContentRepo, mockStatsRepo, mockScoreRepo, mockSearchTermRepo, mockTagRepo, mockProviderRepo, mockCache, scoringService, service.NewTagNormalizer(), mockLogger, time.Minute, entity.SearchConfig{SuggestBelowResults: 1})
+
+	ctx := context.Background()
+	mockCache.On("Get", ctx, mock.AnythingOfType("string"), mock.Anything).Return(false, nil)
+	mockCache.On("Set", ctx, mock.AnythingOfType("string"), mock.Anything, time.Minute).Return(nil)
+
+	mockContentRepo.On("SearchContents", ctx, mock.MatchedBy(func(filters ports.SearchFilters) bool {
+		return filters.FullTextQuery == "progr"
+	}), mock.Anything).Return([]ports.ContentMatch{}, int64(0), nil).Once()
+	mockContentRepo.On("SearchContents", ctx, mock.MatchedBy(func(filters ports.SearchFilters) bool {
+		return filters.FullTextQuery == "" && filters.Query == "progr" && filters.Fuzzy
+	}), mock.Anything).Return([]ports.ContentMatch{
+		{Content: entity.Content{ID: 7, ContentType: entity.ContentTypeArticle, Title: "Programming Go"}},
+	}, int64(1), nil).Once()
+
+	mockStatsRepo.On("GetByContentIDs", ctx, []int64{7}).Return(map[int64]entity.ContentStats{}, nil)
+	mockScoreRepo.On("GetByContentIDs", ctx, []int64{7}).Return(map[int64]entity.ContentScore{}, nil)
+	mockTagRepo.On("GetByContentIDs", ctx, []int64{7}).Return(map[int64][]entity.Tag{}, nil)
+	mockProviderRepo.On("GetByID", ctx, int64(0)).Return(&entity.Provider{}, nil)
+
+	res, err := uc.Execute(ctx, SearchContentsRequest{Query: "progr", Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), res.Total)
+	assert.Equal(t, "Programming Go", res.Items[0].Content.Title)
+	mockContentRepo.AssertExpectations(t)
+}
+
+func TestSearchContentsUseCase_Execute_Suggestions(t *testing.T) {
+	mockContentRepo := new(MockContentRepository)
+	mockSearchTermRepo := new(MockSearchTermRepository)
+	mockCache := new(MockCacheClient)
+	mockLogger := new(MockLogger)
+
+	scoringService := service.NewScoringService(entity.ScoringConfig{}, time.Now)
+	uc := NewSearchContentsUseCase(mockContentRepo, nil, nil, mockSearchTermRepo, nil, nil, mockCache, scoringService, service.NewTagNormalizer(), mockLogger, time.Minute, entity.SearchConfig{})
+
+	ctx := context.Background()
+	mockCache.On("Get", ctx, mock.AnythingOfType("string"), mock.Anything).Return(false, nil)
+	mockContentRepo.On("SearchContents", ctx, mock.Anything, mock.Anything).Return([]ports.ContentMatch{}, int64(0), nil)
+
+	t.Run("Corrects Misspelled Query", func(t *testing.T) {
+		mockSearchTermRepo.On("SuggestTerms", ctx, "iphnoe", int32(3)).Return([]string{"iphone", "phone"}, nil).Once()
+		mockSearchTermRepo.On("SuggestTerms", ctx, "case", int32(3)).Return([]string{"case"}, nil).Once()
+
+		res, err := uc.Execute(ctx, SearchContentsRequest{Query: "iPhnoe case", Page: 1, PageSize: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Items)
+		assert.Equal(t, []string{"iphone case", "phone case"}, res.Suggestions)
 	})
+
+	t.Run("Lookup Failure Drops Suggestions", func(t *testing.T) {
+		mockSearchTermRepo.On("SuggestTerms", ctx, "gola", int32(3)).Return([]string(nil), errors.New("db down")).Once()
+		mockLogger.On("Warn", "failed to suggest search terms", mock.Anything).Return().Once()
+
+		res, err := uc.Execute(ctx, SearchContentsRequest{Query: "gola", Page: 1, PageSize: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Suggestions)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestSortOrderFor(t *testing.T) {
+	assert.Equal(t, ports.SortByScoreDesc, sortOrderFor(""))
+	assert.Equal(t, ports.SortByScoreAsc, sortOrderFor(SortScoreAsc))
+	assert.Equal(t, ports.SortByDateDesc, sortOrderFor(SortRecencyDesc))
+	assert.Equal(t, ports.SortByDateAsc, sortOrderFor(SortDateAsc))
 }
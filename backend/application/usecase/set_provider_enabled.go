@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type SetProviderEnabledRequest struct {
+	Code    string
+	Enabled bool
+}
+
+type SetProviderEnabledUseCase struct {
+	providerRepo ports.ProviderRepository
+}
+
+func NewSetProviderEnabledUseCase(providerRepo ports.ProviderRepository) *SetProviderEnabledUseCase {
+	return &SetProviderEnabledUseCase{
+		providerRepo: providerRepo,
+	}
+}
+
+// Execute enables or disables a provider. Disabled providers keep their
+// contents but are skipped by the next sync.
+func (uc *SetProviderEnabledUseCase) Execute(ctx context.Context, req SetProviderEnabledRequest) (*entity.Provider, error) {
+	provider, err := uc.providerRepo.GetByCode(ctx, req.Code)
+	if err != nil {
+		return nil, fmt.Errorf("get provider: %w", err)
+	}
+	if provider == nil {
+		return nil, ErrProviderNotFound
+	}
+
+	if provider.IsEnabled == req.Enabled {
+		return provider, nil
+	}
+
+	provider.IsEnabled = req.Enabled
+	if err := uc.providerRepo.UpsertProvider(ctx, *provider); err != nil {
+		return nil, fmt.Errorf("save provider: %w", err)
+	}
+
+	return provider, nil
+}
@@ -2,7 +2,13 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
@@ -10,14 +16,35 @@ import (
 	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
 )
 
+var (
+	ErrSyncInProgress = errors.New("sync already in progress")
+	// ErrUnsupportedProviderFormat is returned for providers whose format
+	// has no registered client; no run is recorded for them.
+	ErrUnsupportedProviderFormat = errors.New("unsupported provider format")
+)
+
 type SyncProviderContentsUseCase struct {
 	providerRepo     ports.ProviderRepository
 	contentRepo      ports.ContentRepository
 	contentStatsRepo ports.ContentStatsRepository
 	tagRepo          ports.TagRepository
-	providerClients  map[string]ports.ProviderClient
+	syncRunRepo      ports.SyncRunRepository
+	feedStateRepo    ports.FeedStateRepository
+	rawPayloadRepo   ports.ContentRawPayloadRepository
+	scoreRepo        ports.ContentScoreRepository
+	transactor       ports.Transactor
+	locker           ports.Locker
+	providerClients  ports.ProviderClientRegistry
 	tagNormalizer    *service.TagNormalizer
+	scoringService   *service.ScoringService
 	logger           ports.Logger
+	syncConfig       entity.SyncConfig
+
+	mu       sync.Mutex
+	inFlight map[int64]bool
+	// slots bounds the syncs running at once in this process, scheduled and
+	// triggered alike.
+	slots chan struct{}
 }
 
 func NewSyncProviderContentsUseCase(
@@ -25,64 +52,489 @@ func NewSyncProviderContentsUseCase(
 	contentRepo ports.ContentRepository,
 	contentStatsRepo ports.ContentStatsRepository,
 	tagRepo ports.TagRepository,
-	jsonClient ports.ProviderClient,
-	xmlClient ports.ProviderClient,
+	syncRunRepo ports.SyncRunRepository,
+	feedStateRepo ports.FeedStateRepository,
+	rawPayloadRepo ports.ContentRawPayloadRepository,
+	scoreRepo ports.ContentScoreRepository,
+	transactor ports.Transactor,
+	locker ports.Locker,
+	providerClients ports.ProviderClientRegistry,
 	tagNormalizer *service.TagNormalizer,
+	scoringService *service.ScoringService,
 	logger ports.Logger,
+	syncConfig entity.SyncConfig,
 ) *SyncProviderContentsUseCase {
 	return &SyncProviderContentsUseCase{
 		providerRepo:     providerRepo,
 		contentRepo:      contentRepo,
 		contentStatsRepo: contentStatsRepo,
 		tagRepo:          tagRepo,
-		providerClients: map[string]ports.ProviderClient{
-			entity.ProviderFormatJSON: jsonClient,
-			entity.ProviderFormatXML:  xmlClient,
-		},
-		tagNormalizer: tagNormalizer,
-		logger:        logger,
+		syncRunRepo:      syncRunRepo,
+		feedStateRepo:    feedStateRepo,
+		rawPayloadRepo:   rawPayloadRepo,
+		scoreRepo:        scoreRepo,
+		transactor:       transactor,
+		locker:           locker,
+		providerClients:  providerClients,
+		tagNormalizer:    tagNormalizer,
+		scoringService:   scoringService,
+		logger:           logger,
+		syncConfig:       syncConfig,
+		inFlight:         make(map[int64]bool),
+		slots:            make(chan struct{}, syncConfig.GetConcurrency()),
 	}
 }
 
-func (uc *SyncProviderContentsUseCase) ExecuteAll(ctx context.Context) error {
+// ProviderSyncResult is the outcome of one provider's sync within a batch.
+// Run is nil when the sync never started.
+type ProviderSyncResult struct {
+	Provider entity.Provider
+	Run      *entity.SyncRun
+	Err      error
+}
+
+type SyncAllResult struct {
+	// Results follow the order of the synced providers.
+	Results   []ProviderSyncResult
+	Succeeded int
+	Failed    int
+	Skipped   int
+	// Unsupported counts providers whose format has no client.
+	Unsupported int
+}
+
+// ExecuteAll syncs every enabled provider. Per-provider failures are logged
+// and reported in the result; only failing to list providers is an error.
+func (uc *SyncProviderContentsUseCase) ExecuteAll(ctx context.Context) (*SyncAllResult, error) {
 	providers, err := uc.providerRepo.GetAllEnabled(ctx)
 	if err != nil {
-		return fmt.Errorf("get all enabled providers: %w", err)
+		return nil, fmt.Errorf("get all enabled providers: %w", err)
 	}
 
 	uc.logger.Info("starting sync for all providers", loggerPkg.Int("provider_count", len(providers)))
 
-	for _, provider := range providers {
-		if err := uc.ExecuteForProvider(ctx, provider); err != nil {
-			uc.logger.Error("sync failed for provider",
+	return uc.ExecuteProviders(ctx, providers), nil
+}
+
+// ExecuteProviders syncs providers concurrently, at most the configured
+// concurrency at a time, each bounded by the per-provider timeout. Providers
+// not yet started when ctx is cancelled fail with ctx's error.
+func (uc *SyncProviderContentsUseCase) ExecuteProviders(ctx context.Context, providers []entity.Provider) *SyncAllResult {
+	result := &SyncAllResult{Results: make([]ProviderSyncResult, len(providers))}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(uc.syncConfig.GetConcurrency(), len(providers)) {
+		wg.Go(func() {
+			for i := range jobs {
+				result.Results[i] = uc.executeWithTimeout(ctx, providers[i], nil)
+			}
+		})
+	}
+	for i := range providers {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, res := range result.Results {
+		switch {
+		case errors.Is(res.Err, ErrSyncInProgress):
+			result.Skipped++
+		case errors.Is(res.Err, ErrUnsupportedProviderFormat):
+			result.Unsupported++
+		case res.Err != nil:
+			result.Failed++
+		default:
+			result.Succeeded++
+		}
+	}
+
+	uc.logger.Info("finished provider syncs",
+		loggerPkg.Int("succeeded", result.Succeeded),
+		loggerPkg.Int("failed", result.Failed),
+		loggerPkg.Int("skipped", result.Skipped),
+		loggerPkg.Int("unsupported", result.Unsupported))
+
+	return result
+}
+
+// executeWithTimeout syncs provider once a sync slot is free, bounded by the
+// per-provider timeout. started is the run already recorded for a triggered
+// sync whose provider is claimed in this process; nil records a new run.
+func (uc *SyncProviderContentsUseCase) executeWithTimeout(ctx context.Context, provider entity.Provider, started *entity.SyncRun) ProviderSyncResult {
+	if err := ctx.Err(); err != nil {
+		return ProviderSyncResult{Provider: provider, Err: err}
+	}
+	select {
+	case uc.slots <- struct{}{}:
+		defer func() { <-uc.slots }()
+	case <-ctx.Done():
+		return ProviderSyncResult{Provider: provider, Err: ctx.Err()}
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, uc.syncConfig.GetProviderTimeout())
+	defer cancel()
+
+	var run *entity.SyncRun
+	var err error
+	if started == nil {
+		run, err = uc.ExecuteForProvider(providerCtx, provider)
+	} else {
+		run, err = started, uc.executeRun(providerCtx, provider, started)
+	}
+	switch {
+	case errors.Is(err, ErrSyncInProgress):
+		uc.logger.Info("skipping provider, sync already in progress", loggerPkg.String("provider_code", provider.Code))
+	case errors.Is(err, ErrUnsupportedProviderFormat):
+		uc.logger.Warn("skipping provider, no client reads its format",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.String("format", provider.Format))
+	case err != nil:
+		uc.logger.Error("sync failed for provider",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Error(err))
+	default:
+		uc.logger.Info("synced provider successfully", loggerPkg.String("provider_code", provider.Code))
+	}
+
+	return ProviderSyncResult{Provider: provider, Run: run, Err: err}
+}
+
+// ExecuteForProvider syncs a single provider and records the outcome in
+// provider_sync_runs. The returned run is populated even when the sync fails.
+// It returns ErrSyncInProgress if the provider is already being synced here
+// or on another replica, and ErrUnsupportedProviderFormat if no client reads
+// its format.
+func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, provider entity.Provider) (*entity.SyncRun, error) {
+	if _, err := uc.client(provider); err != nil {
+		return nil, err
+	}
+	release, err := uc.acquire(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	run := uc.newRun(provider)
+	runID, err := uc.syncRunRepo.Create(ctx, *run)
+	if err != nil {
+		uc.logger.Error("failed to record sync run start",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Error(err))
+	}
+	run.ID = runID
+
+	syncErr := uc.syncProvider(ctx, provider, run)
+	uc.finishRun(ctx, provider, run, syncErr)
+
+	return run, syncErr
+}
+
+// StartForProvider records a new run and syncs the provider in the
+// background, detached from ctx so the sync outlives the caller. The sync
+// waits for a free sync slot and is bounded by the per-provider timeout, like
+// a scheduled one. The run is returned as soon as it is recorded; its outcome
+// is written to provider_sync_runs when the sync finishes. A run whose
+// provider turns out to be syncing on another replica fails with
+// ErrSyncInProgress.
+func (uc *SyncProviderContentsUseCase) StartForProvider(ctx context.Context, provider entity.Provider) (*entity.SyncRun, error) {
+	if _, err := uc.client(provider); err != nil {
+		return nil, err
+	}
+	if !uc.claimLocal(provider.ID) {
+		return nil, ErrSyncInProgress
+	}
+
+	run := uc.newRun(provider)
+	runID, err := uc.syncRunRepo.Create(ctx, *run)
+	if err != nil {
+		uc.releaseLocal(provider.ID)
+		return nil, fmt.Errorf("create sync run: %w", err)
+	}
+	run.ID = runID
+	started := *run
+
+	go func() {
+		defer uc.releaseLocal(provider.ID)
+		uc.executeWithTimeout(context.WithoutCancel(ctx), provider, run)
+	}()
+
+	return &started, nil
+}
+
+// executeRun syncs provider into run, which is already recorded and whose
+// provider is claimed in this process. The shared lock is taken only now so
+// that syncs waiting for a slot do not hold a connection each.
+func (uc *SyncProviderContentsUseCase) executeRun(ctx context.Context, provider entity.Provider, run *entity.SyncRun) error {
+	unlock, err := uc.lockShared(ctx, provider)
+	if err != nil {
+		uc.finishRun(ctx, provider, run, err)
+		return err
+	}
+	defer unlock()
+
+	syncErr := uc.syncProvider(ctx, provider, run)
+	uc.finishRun(ctx, provider, run, syncErr)
+	return syncErr
+}
+
+func (uc *SyncProviderContentsUseCase) newRun(provider entity.Provider) *entity.SyncRun {
+	return &entity.SyncRun{
+		ProviderID: provider.ID,
+		StartedAt:  time.Now().UTC(),
+		Status:     entity.SyncRunStatusRunning,
+	}
+}
+
+// acquire claims the provider for one sync, within this process and through
+// the shared lock across replicas, and returns the func that gives it back.
+// It returns ErrSyncInProgress if the provider is already claimed.
+func (uc *SyncProviderContentsUseCase) acquire(ctx context.Context, provider entity.Provider) (func(), error) {
+	if !uc.claimLocal(provider.ID) {
+		return nil, ErrSyncInProgress
+	}
+
+	unlock, err := uc.lockShared(ctx, provider)
+	if err != nil {
+		uc.releaseLocal(provider.ID)
+		return nil, err
+	}
+
+	return func() {
+		unlock()
+		uc.releaseLocal(provider.ID)
+	}, nil
+}
+
+// claimLocal claims the provider within this process; it reports false if
+// the provider is already claimed.
+func (uc *SyncProviderContentsUseCase) claimLocal(providerID int64) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.inFlight[providerID] {
+		return false
+	}
+	uc.inFlight[providerID] = true
+	return true
+}
+
+// lockShared takes the provider's lock across replicas and returns the func
+// that releases it. It returns ErrSyncInProgress if another replica holds it.
+func (uc *SyncProviderContentsUseCase) lockShared(ctx context.Context, provider entity.Provider) (func(), error) {
+	lock, err := uc.locker.TryLock(ctx, fmt.Sprintf("sync:provider:%d", provider.ID))
+	if err != nil {
+		return nil, fmt.Errorf("lock provider: %w", err)
+	}
+	if lock == nil {
+		return nil, ErrSyncInProgress
+	}
+
+	return func() {
+		if err := lock.Release(context.WithoutCancel(ctx)); err != nil {
+			uc.logger.Warn("failed to release provider sync lock",
 				loggerPkg.String("provider_code", provider.Code),
 				loggerPkg.Error(err))
-			continue
 		}
-		uc.logger.Info("synced provider successfully", loggerPkg.String("provider_code", provider.Code))
+	}, nil
+}
+
+func (uc *SyncProviderContentsUseCase) releaseLocal(providerID int64) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	delete(uc.inFlight, providerID)
+}
+
+func (uc *SyncProviderContentsUseCase) finishRun(ctx context.Context, provider entity.Provider, run *entity.SyncRun, syncErr error) {
+	finishedAt := time.Now().UTC()
+	run.FinishedAt = &finishedAt
+	run.Status = entity.SyncRunStatusSuccess
+	if syncErr != nil {
+		run.Status = entity.SyncRunStatusFailed
+		run.ErrorMessage = syncErr.Error()
 	}
 
-	return nil
+	if run.ID == 0 {
+		return
+	}
+
+	// The run must be closed even if the sync was cancelled mid-way.
+	if err := uc.syncRunRepo.Update(context.WithoutCancel(ctx), *run); err != nil {
+		uc.logger.Error("failed to record sync run result",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Int64("sync_run_id", run.ID),
+			loggerPkg.Error(err))
+	}
 }
 
-func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, provider entity.Provider) error {
-	client, ok := uc.providerClients[provider.Format]
-	if !ok {
-		return fmt.Errorf("no client registered for provider format: %s", provider.Format)
+func (uc *SyncProviderContentsUseCase) syncProvider(ctx context.Context, provider entity.Provider, run *entity.SyncRun) error {
+	client, err := uc.client(provider)
+	if err != nil {
+		return err
+	}
+
+	validators, err := uc.feedValidators(ctx, provider)
+	if err != nil {
+		return err
 	}
 
-	items, err := client.FetchContents(ctx, provider)
+	// Items are written in batches as they are decoded, so the feed is never
+	// held in memory as a whole. The fetch runs in one transaction, so a
+	// failure part way through leaves nothing of the feed behind. Write
+	// counts are only kept if it commits.
+	fetchedAt := time.Now().UTC()
+	activeBefore := int64(-1)
+	written := *run
+	var seenIDs []string
+	var writeErr error
+	writeBatch := func(ctx context.Context, items []ports.ProviderContentItem) error {
+		if activeBefore < 0 {
+			count, err := uc.contentRepo.CountActiveByProvider(ctx, provider.ID)
+			if err != nil {
+				writeErr = fmt.Errorf("count active contents: %w", err)
+				return writeErr
+			}
+			activeBefore = count
+		}
+
+		run.ItemCount += int32(len(items))
+		batchSeenIDs, err := uc.writeItems(ctx, provider, items, fetchedAt, &written)
+		if err != nil {
+			writeErr = err
+			return writeErr
+		}
+		seenIDs = append(seenIDs, batchSeenIDs...)
+		return nil
+	}
+
+	batchSize := uc.syncConfig.GetBatchSize()
+	handlePage := func(ctx context.Context, items ports.ContentItems) error {
+		batch := make([]ports.ProviderContentItem, 0, batchSize)
+		for item, err := range items {
+			if err != nil {
+				return err
+			}
+			batch = append(batch, item)
+			if len(batch) == batchSize {
+				if err := writeBatch(ctx, batch); err != nil {
+					return err
+				}
+				batch = batch[:0]
+			}
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		return writeBatch(ctx, batch)
+	}
+
+	var fetched *ports.FetchResult
+	err = uc.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		fetched, err = client.FetchContents(txCtx, provider, validators, handlePage)
+		if writeErr != nil {
+			return writeErr
+		}
+		if err != nil {
+			return fmt.Errorf("fetch contents: %w", err)
+		}
+		// An empty fetch is indistinguishable from an outage, so it must
+		// never reach reconciliation.
+		if fetched.NotModified || fetched.ItemCount == 0 {
+			return nil
+		}
+
+		if err := uc.reconcile(txCtx, provider, seenIDs, activeBefore, fetchedAt, fetched.Truncated, &written); err != nil {
+			return err
+		}
+		// Validators are only remembered once the feed they describe is
+		// stored, so a failed sync is retried with a full fetch.
+		err = uc.feedStateRepo.Save(txCtx, entity.FeedState{
+			ProviderID: provider.ID,
+			FeedURL:    provider.BaseURL,
+			Validators: fetched.Validators,
+		})
+		if err != nil {
+			return fmt.Errorf("save feed state: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("fetch contents: %w", err)
+		return err
 	}
+	written.ItemCount = run.ItemCount
+	*run = written
 
-	if len(items) == 0 {
+	if fetched.NotModified {
+		run.NotModified = true
+		uc.logger.Info("provider feed not modified", loggerPkg.String("provider_code", provider.Code))
+		return nil
+	}
+	if fetched.ItemCount == 0 {
 		uc.logger.Info("no items fetched from provider", loggerPkg.String("provider_code", provider.Code))
 		return nil
 	}
 
-	contents := make([]entity.Content, 0, len(items))
+	uc.logger.Info("synced provider items successfully",
+		loggerPkg.String("provider_code", provider.Code),
+		loggerPkg.Int("item_count", fetched.ItemCount),
+		loggerPkg.Int("unchanged_count", int(run.UnchangedCount)))
+
+	return nil
+}
+
+// client returns the client reading the provider's format.
+func (uc *SyncProviderContentsUseCase) client(provider entity.Provider) (ports.ProviderClient, error) {
+	client, ok := uc.providerClients.Client(provider.Format)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedProviderFormat, provider.Format)
+	}
+	return client, nil
+}
+
+// feedValidators returns the validators of the provider's last stored feed,
+// or none if the feed URL has changed since.
+func (uc *SyncProviderContentsUseCase) feedValidators(ctx context.Context, provider entity.Provider) (entity.FeedValidators, error) {
+	state, err := uc.feedStateRepo.Get(ctx, provider.ID)
+	if err != nil {
+		return entity.FeedValidators{}, fmt.Errorf("get feed state: %w", err)
+	}
+	if state == nil || state.FeedURL != provider.BaseURL {
+		return entity.FeedValidators{}, nil
+	}
+	return state.Validators, nil
+}
+
+// writeItems persists contents, stats, scores, raw payloads, tags and search
+// documents for one page of a fetch and returns the provider content IDs it
+// saw. Items whose hash matches the stored one are only marked as seen. It is
+// expected to run in the sync's transaction, so any error aborts the sync.
+func (uc *SyncProviderContentsUseCase) writeItems(ctx context.Context, provider entity.Provider, items []ports.ProviderContentItem, fetchedAt time.Time, run *entity.SyncRun) ([]string, error) {
+	seenIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ProviderContentID == "" {
+			run.SkippedCount++
+			continue
+		}
+		seenIDs = append(seenIDs, item.ProviderContentID)
+	}
+
+	storedHashes, err := uc.contentRepo.GetContentHashes(ctx, provider.ID, seenIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get content hashes: %w", err)
+	}
+
+	contents := make([]entity.Content, 0, len(seenIDs))
+	unchangedIDs := make([]string, 0, len(storedHashes))
 	for _, item := range items {
+		if item.ProviderContentID == "" {
+			continue
+		}
+		hash := contentHash(provider, item)
+		if storedHashes[item.ProviderContentID] == hash {
+			unchangedIDs = append(unchangedIDs, item.ProviderContentID)
+			continue
+		}
 		contents = append(contents, entity.Content{
 			ProviderID:        provider.ID,
 			ProviderContentID: item.ProviderContentID,
@@ -90,33 +542,37 @@ func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, p
 			ContentType:       entity.ContentType(item.ContentType),
 			PublishedAt:       item.PublishedAt,
 			IsActive:          true,
+			ContentHash:       hash,
 		})
 	}
 
-	if err := uc.contentRepo.SaveOrUpdateContents(ctx, contents); err != nil {
-		return fmt.Errorf("save contents: %w", err)
+	if len(unchangedIDs) > 0 {
+		if err := uc.contentRepo.MarkSeen(ctx, provider.ID, unchangedIDs); err != nil {
+			return nil, fmt.Errorf("mark unchanged contents seen: %w", err)
+		}
+		run.UnchangedCount += int32(len(unchangedIDs))
 	}
-
-	savedContents, _, err := uc.contentRepo.SearchContents(ctx, ports.SearchFilters{}, ports.Pagination{Page: 1, PageSize: 10000})
-	if err != nil {
-		return fmt.Errorf("get saved contents: %w", err)
+	if len(contents) == 0 {
+		return seenIDs, nil
 	}
 
-	providerContentIDMap := make(map[string]int64)
-	for _, content := range savedContents {
-		if content.ProviderID == provider.ID {
-			providerContentIDMap[content.ProviderContentID] = content.ID
-		}
+	providerContentIDMap, err := uc.contentRepo.SaveOrUpdateContents(ctx, contents)
+	if err != nil {
+		return nil, fmt.Errorf("save contents: %w", err)
 	}
+	run.UpsertedCount += int32(len(providerContentIDMap))
 
+	computedAt := time.Now().UTC()
 	stats := make([]entity.ContentStats, 0, len(items))
+	scores := make([]entity.ContentScore, 0, len(items))
+	payloads := make([]entity.ContentRawPayload, 0, len(items))
 	for _, item := range items {
 		contentID, ok := providerContentIDMap[item.ProviderContentID]
 		if !ok {
 			continue
 		}
 
-		stats = append(stats, entity.ContentStats{
+		stat := entity.ContentStats{
 			ContentID:   contentID,
 			Views:       item.Views,
 			Likes:       item.Likes,
@@ -124,11 +580,41 @@ func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, p
 			ReadingTime: item.ReadingTime,
 			Reactions:   item.Reactions,
 			Comments:    item.Comments,
+		}
+		stats = append(stats, stat)
+
+		content := entity.Content{
+			ID:          contentID,
+			ContentType: entity.ContentType(item.ContentType),
+			PublishedAt: item.PublishedAt,
+		}
+		scores = append(scores, entity.ContentScore{
+			ContentID:  contentID,
+			Components: uc.scoringService.Calculate(content, stat),
+			ComputedAt: computedAt,
 		})
+
+		if json.Valid(item.RawPayload) {
+			payloads = append(payloads, entity.ContentRawPayload{
+				ContentID:  contentID,
+				ProviderID: provider.ID,
+				RawPayload: item.RawPayload,
+				FetchedAt:  fetchedAt,
+			})
+		}
 	}
 
 	if err := uc.contentStatsRepo.SaveOrUpdateStats(ctx, stats); err != nil {
-		return fmt.Errorf("save content stats: %w", err)
+		return nil, fmt.Errorf("save content stats: %w", err)
+	}
+	run.StatsCount += int32(len(stats))
+
+	if err := uc.scoreRepo.SaveOrUpdateScores(ctx, scores); err != nil {
+		return nil, fmt.Errorf("save content scores: %w", err)
+	}
+
+	if err := uc.rawPayloadRepo.SaveOrUpdatePayloads(ctx, payloads); err != nil {
+		return nil, fmt.Errorf("save raw payloads: %w", err)
 	}
 
 	for _, item := range items {
@@ -140,10 +626,7 @@ func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, p
 		normalizedTags := uc.tagNormalizer.Normalize(item.Tags)
 		tags, err := uc.tagRepo.EnsureTags(ctx, normalizedTags)
 		if err != nil {
-			uc.logger.Error("failed to ensure tags",
-				loggerPkg.String("provider_content_id", item.ProviderContentID),
-				loggerPkg.Error(err))
-			continue
+			return nil, fmt.Errorf("ensure tags for %s: %w", item.ProviderContentID, err)
 		}
 
 		tagIDs := make([]int64, len(tags))
@@ -152,15 +635,70 @@ func (uc *SyncProviderContentsUseCase) ExecuteForProvider(ctx context.Context, p
 		}
 
 		if err := uc.tagRepo.AssignToContent(ctx, contentID, tagIDs); err != nil {
-			uc.logger.Error("failed to assign tags",
-				loggerPkg.Int64("content_id", contentID),
-				loggerPkg.Error(err))
+			return nil, fmt.Errorf("assign tags to content %d: %w", contentID, err)
 		}
+		run.TagsCount++
 	}
 
-	uc.logger.Info("synced provider items successfully",
-		loggerPkg.String("provider_code", provider.Code),
-		loggerPkg.Int("item_count", len(items)))
+	contentIDs := make([]int64, 0, len(providerContentIDMap))
+	for _, contentID := range providerContentIDMap {
+		contentIDs = append(contentIDs, contentID)
+	}
+	if err := uc.contentRepo.RefreshSearchDocuments(ctx, contentIDs); err != nil {
+		return nil, fmt.Errorf("refresh search documents: %w", err)
+	}
+
+	return seenIDs, nil
+}
+
+// reconcile updates is_active for the provider's catalogue based on the latest
+// fetch. A fetch noticeably smaller than the current active catalogue, or cut
+// short by the page limit, is treated as partial: seen items are still
+// reactivated but nothing is counted as missing.
+func (uc *SyncProviderContentsUseCase) reconcile(ctx context.Context, provider entity.Provider, seenIDs []string, activeBefore int64, fetchedAt time.Time, truncated bool, run *entity.SyncRun) error {
+	opts := ports.ReconcileOptions{
+		DeactivateMissing: float64(len(seenIDs)) >= float64(activeBefore)*uc.syncConfig.GetMinFetchRatio(),
+		MinMissedSyncs:    uc.syncConfig.GetDeactivateAfterMisses(),
+		SeenBefore:        fetchedAt.Add(-uc.syncConfig.GetDeactivateGrace()),
+	}
+	switch {
+	case truncated:
+		opts.DeactivateMissing = false
+		uc.logger.Warn("fetch stopped at a feed limit, skipping deactivation",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Int("fetched_count", len(seenIDs)))
+	case !opts.DeactivateMissing:
+		uc.logger.Warn("fetch looks partial, skipping deactivation",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Int("fetched_count", len(seenIDs)),
+			loggerPkg.Int64("active_count", activeBefore))
+	}
+
+	result, err := uc.contentRepo.ReconcileProviderContents(ctx, provider.ID, seenIDs, opts)
+	if err != nil {
+		return fmt.Errorf("reconcile contents: %w", err)
+	}
+	run.ReactivatedCount = int32(result.Reactivated)
+	run.DeactivatedCount = int32(result.Deactivated)
 
 	return nil
 }
+
+// contentHashVersion is part of every content hash. Bump it when a sync
+// would write an unchanged item differently, e.g. when tag normalization
+// changes, so that every item is written again.
+const contentHashVersion = 1
+
+// contentHash fingerprints everything a sync writes for an item: the item,
+// the format and mapping it was read with, and contentHashVersion. An
+// unchanged hash means the item can be skipped.
+func contentHash(provider entity.Provider, item ports.ProviderContentItem) string {
+	encoded, _ := json.Marshal(struct {
+		Version int
+		Format  string
+		Mapping entity.FeedMapping
+		Item    ports.ProviderContentItem
+	}{contentHashVersion, provider.Format, provider.Mapping, item})
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
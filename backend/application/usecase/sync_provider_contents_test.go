@@ -2,11 +2,16 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	"github.com/mehmetymw/search-aggregation-service/backend/infrastructure/providers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -16,6 +21,11 @@ func TestSyncProviderContentsUseCase_ExecuteForProvider(t *testing.T) {
 	mockContentRepo := new(MockContentRepository)
 	mockStatsRepo := new(MockContentStatsRepository)
 	mockTagRepo := new(MockTagRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockRawPayloadRepo := new(MockContentRawPayloadRepository)
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockTransactor := new(MockTransactor)
 	mockJsonClient := new(MockProviderClient)
 	mockXmlClient := new(MockProviderClient)
 	mockLogger := new(MockLogger)
@@ -26,18 +36,30 @@ func TestSyncProviderContentsUseCase_ExecuteForProvider(t *testing.T) {
 		mockContentRepo,
 		mockStatsRepo,
 		mockTagRepo,
-		mockJsonClient,
-		mockXmlClient,
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		mockRawPayloadRepo,
+		mockScoreRepo,
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient, entity.ProviderFormatXML: mockXmlClient},
 		tagNormalizer,
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
 		mockLogger,
+		entity.SyncConfig{DeactivateAfterMisses: 2, MinFetchRatio: 0.5},
 	)
 
 	ctx := context.Background()
+	mockTransactor.On("WithinTransaction", ctx).Return(nil)
+	mockScoreRepo.On("SaveOrUpdateScores", ctx, mock.Anything).Return(nil)
 	provider := entity.Provider{
-		ID:     1,
-		Code:   "provider1",
-		Format: entity.ProviderFormatJSON,
+		ID:      1,
+		Code:    "provider1",
+		Format:  entity.ProviderFormatJSON,
+		BaseURL: "https://provider1.example.com/feed",
 	}
+	mockFeedStateRepo.On("Get", ctx, int64(1)).Return(nil, nil)
+	mockContentRepo.On("GetContentHashes", ctx, int64(1), mock.Anything).Return(map[string]string{}, nil)
 
 	t.Run("Success", func(t *testing.T) {
 		items := []ports.ProviderContentItem{
@@ -45,26 +67,611 @@ func TestSyncProviderContentsUseCase_ExecuteForProvider(t *testing.T) {
 				ProviderContentID: "p1",
 				Title:             "Title 1",
 				Tags:              []string{"Tag1", "Tag2"},
+				RawPayload:        []byte(`{"id":"p1","title":"Title 1"}`),
 			},
 		}
-		mockJsonClient.On("FetchContents", ctx, provider).Return(items, nil)
+		mockJsonClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return([][]ports.ProviderContentItem{items}, &ports.FetchResult{
+			Pages:      1,
+			ItemCount:  len(items),
+			Validators: entity.FeedValidators{ETag: `"v1"`},
+		}, nil).Once()
 
-		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(nil)
-		
-		// Mock searching back the saved contents to get IDs
-		savedContents := []entity.Content{
-			{ID: 101, ProviderID: 1, ProviderContentID: "p1"},
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(1)).Return(int64(2), nil).Once()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p1": 101}, nil).Once()
+		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil).Once()
+
+		mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, mock.MatchedBy(func(payloads []entity.ContentRawPayload) bool {
+			return len(payloads) == 1 &&
+				payloads[0].ContentID == 101 &&
+				payloads[0].ProviderID == 1 &&
+				string(payloads[0].RawPayload) == `{"id":"p1","title":"Title 1"}`
+		})).Return(nil).Once()
+
+		mockTagRepo.On("EnsureTags", ctx, mock.Anything).Return([]entity.Tag{{ID: 1, Name: "tag1"}, {ID: 2, Name: "tag2"}}, nil).Once()
+		mockTagRepo.On("AssignToContent", ctx, int64(101), mock.Anything).Return(nil).Once()
+		mockContentRepo.On("RefreshSearchDocuments", ctx, []int64{101}).Return(nil).Once()
+
+		mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+		mockContentRepo.On("ReconcileProviderContents", ctx, int64(1), []string{"p1"}, mock.MatchedBy(func(opts ports.ReconcileOptions) bool {
+			return opts.DeactivateMissing && opts.MinMissedSyncs == 2
+		})).Return(ports.ContentReconciliation{Deactivated: 1}, nil).Once()
+		mockFeedStateRepo.On("Save", ctx, entity.FeedState{
+			ProviderID: 1,
+			FeedURL:    provider.BaseURL,
+			Validators: entity.FeedValidators{ETag: `"v1"`},
+		}).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ProviderID == 1 && run.Status == entity.SyncRunStatusRunning
+		})).Return(int64(7), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 7 && run.Status == entity.SyncRunStatusSuccess
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, provider)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), run.ID)
+		assert.Equal(t, entity.SyncRunStatusSuccess, run.Status)
+		assert.Equal(t, int32(1), run.ItemCount)
+		assert.Equal(t, int32(1), run.UpsertedCount)
+		assert.Equal(t, int32(1), run.StatsCount)
+		assert.Equal(t, int32(1), run.TagsCount)
+		assert.Equal(t, int32(0), run.SkippedCount)
+		assert.Equal(t, int32(1), run.DeactivatedCount)
+		assert.NotNil(t, run.FinishedAt)
+		mockSyncRunRepo.AssertExpectations(t)
+		mockRawPayloadRepo.AssertExpectations(t)
+		mockFeedStateRepo.AssertExpectations(t)
+	})
+
+	t.Run("Partial Fetch Skips Deactivation", func(t *testing.T) {
+		items := []ports.ProviderContentItem{
+			{ProviderContentID: "p1", Title: "Title 1"},
+		}
+		mockJsonClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return(fetchedPages(items)...).Once()
+
+		// One item against an active catalogue of ten is below the 0.5 ratio.
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(1)).Return(int64(10), nil).Once()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p1": 101}, nil).Once()
+		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil).Once()
+		mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, []entity.ContentRawPayload{}).Return(nil).Once()
+		mockContentRepo.On("RefreshSearchDocuments", ctx, []int64{101}).Return(nil).Once()
+		mockLogger.On("Warn", "fetch looks partial, skipping deactivation", mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		mockContentRepo.On("ReconcileProviderContents", ctx, int64(1), []string{"p1"}, mock.MatchedBy(func(opts ports.ReconcileOptions) bool {
+			return !opts.DeactivateMissing
+		})).Return(ports.ContentReconciliation{Reactivated: 1}, nil).Once()
+		mockFeedStateRepo.On("Save", ctx, mock.Anything).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(9), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 9 && run.ReactivatedCount == 1 && run.DeactivatedCount == 0
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, provider)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), run.ReactivatedCount)
+		assert.Equal(t, int32(0), run.DeactivatedCount)
+		mockContentRepo.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("Write Failure Discards Counts", func(t *testing.T) {
+		items := []ports.ProviderContentItem{
+			{ProviderContentID: "p1", Title: "Title 1", Tags: []string{"Tag1"}},
+		}
+		mockJsonClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return(fetchedPages(items)...).Once()
+
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(1)).Return(int64(1), nil).Once()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p1": 101}, nil).Once()
+		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil).Once()
+		mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, []entity.ContentRawPayload{}).Return(nil).Once()
+		mockTagRepo.On("EnsureTags", ctx, []string{"tag1"}).Return([]entity.Tag(nil), errors.New("deadlock detected")).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(10), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 10 && run.Status == entity.SyncRunStatusFailed && run.UpsertedCount == 0
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, provider)
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), run.ItemCount)
+		assert.Equal(t, int32(0), run.UpsertedCount)
+		assert.Equal(t, int32(0), run.StatsCount)
+		mockSyncRunRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fetch Failure Is Recorded", func(t *testing.T) {
+		failingProvider := entity.Provider{
+			ID:     2,
+			Code:   "provider2",
+			Format: entity.ProviderFormatXML,
+		}
+		mockFeedStateRepo.On("Get", ctx, int64(2)).Return(nil, nil).Once()
+		mockXmlClient.On("FetchContents", ctx, failingProvider, entity.FeedValidators{}).Return(nil, nil, errors.New("connection refused"))
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(8), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 8 &&
+				run.Status == entity.SyncRunStatusFailed &&
+				run.ErrorMessage == "fetch contents: connection refused"
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, failingProvider)
+		assert.Error(t, err)
+		assert.Equal(t, entity.SyncRunStatusFailed, run.Status)
+		mockSyncRunRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not Modified Skips Writes", func(t *testing.T) {
+		cachedProvider := entity.Provider{
+			ID:      3,
+			Code:    "provider3",
+			Format:  entity.ProviderFormatJSON,
+			BaseURL: "https://provider3.example.com/feed",
+		}
+		validators := entity.FeedValidators{ETag: `"v3"`, LastModified: "Wed, 25 Oct 2023 12:00:00 GMT"}
+		mockFeedStateRepo.On("Get", ctx, int64(3)).Return(&entity.FeedState{
+			ProviderID: 3,
+			FeedURL:    cachedProvider.BaseURL,
+			Validators: validators,
+		}, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, cachedProvider, validators).Return(nil, &ports.FetchResult{
+			NotModified: true,
+			Validators:  validators,
+		}, nil).Once()
+
+		mockLogger.On("Info", "provider feed not modified", mock.Anything).Return().Once()
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(11), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 11 && run.Status == entity.SyncRunStatusSuccess && run.NotModified
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, cachedProvider)
+		assert.NoError(t, err)
+		assert.True(t, run.NotModified)
+		assert.Equal(t, int32(0), run.ItemCount)
+		mockContentRepo.AssertNotCalled(t, "CountActiveByProvider", ctx, int64(3))
+		mockSyncRunRepo.AssertExpectations(t)
+	})
+
+	t.Run("Moved Feed Is Fetched Unconditionally", func(t *testing.T) {
+		movedProvider := entity.Provider{
+			ID:      4,
+			Code:    "provider4",
+			Format:  entity.ProviderFormatJSON,
+			BaseURL: "https://provider4.example.com/v2/feed",
+		}
+		mockFeedStateRepo.On("Get", ctx, int64(4)).Return(&entity.FeedState{
+			ProviderID: 4,
+			FeedURL:    "https://provider4.example.com/v1/feed",
+			Validators: entity.FeedValidators{ETag: `"v1"`},
+		}, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, movedProvider, entity.FeedValidators{}).Return(nil, &ports.FetchResult{}, nil).Once()
+
+		mockLogger.On("Info", "no items fetched from provider", mock.Anything).Return().Once()
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(12), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, movedProvider)
+		assert.NoError(t, err)
+		assert.False(t, run.NotModified)
+		mockJsonClient.AssertExpectations(t)
+	})
+
+	t.Run("Unchanged Items Are Only Marked Seen", func(t *testing.T) {
+		unchangedProvider := entity.Provider{ID: 5, Code: "provider5", Format: entity.ProviderFormatJSON}
+		items := []ports.ProviderContentItem{
+			{ProviderContentID: "p1", Title: "Title 1", Tags: []string{"Tag1"}},
+		}
+		mockFeedStateRepo.On("Get", ctx, int64(5)).Return(nil, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, unchangedProvider, entity.FeedValidators{}).Return(fetchedPages(items)...).Once()
+
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(5)).Return(int64(1), nil).Once()
+		mockContentRepo.On("GetContentHashes", ctx, int64(5), []string{"p1"}).Return(map[string]string{"p1": contentHash(unchangedProvider, items[0])}, nil).Once()
+		mockContentRepo.On("MarkSeen", ctx, int64(5), []string{"p1"}).Return(nil).Once()
+		mockContentRepo.On("ReconcileProviderContents", ctx, int64(5), []string{"p1"}, mock.Anything).Return(ports.ContentReconciliation{}, nil).Once()
+		mockFeedStateRepo.On("Save", ctx, mock.Anything).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(13), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 13 && run.UnchangedCount == 1 && run.UpsertedCount == 0
+		})).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, unchangedProvider)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), run.UnchangedCount)
+		assert.Equal(t, int32(0), run.UpsertedCount)
+		assert.Equal(t, int32(0), run.TagsCount)
+		mockContentRepo.AssertExpectations(t)
+		mockSyncRunRepo.AssertExpectations(t)
+	})
+
+	t.Run("Pages Are Written As They Arrive", func(t *testing.T) {
+		pagedProvider := entity.Provider{ID: 6, Code: "provider6", Format: entity.ProviderFormatJSON}
+		first := []ports.ProviderContentItem{{ProviderContentID: "p1", Title: "Title 1"}}
+		second := []ports.ProviderContentItem{{ProviderContentID: "p2", Title: "Title 2"}}
+		mockFeedStateRepo.On("Get", ctx, int64(6)).Return(nil, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, pagedProvider, entity.FeedValidators{}).Return(fetchedPages(first, second)...).Once()
+
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(6)).Return(int64(2), nil).Once()
+		mockContentRepo.On("GetContentHashes", ctx, int64(6), mock.Anything).Return(map[string]string{}, nil).Twice()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p1": 101}, nil).Once()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p2": 102}, nil).Once()
+		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil).Twice()
+		mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, []entity.ContentRawPayload{}).Return(nil).Twice()
+		mockContentRepo.On("RefreshSearchDocuments", ctx, []int64{101}).Return(nil).Once()
+		mockContentRepo.On("RefreshSearchDocuments", ctx, []int64{102}).Return(nil).Once()
+		mockContentRepo.On("ReconcileProviderContents", ctx, int64(6), []string{"p1", "p2"}, mock.MatchedBy(func(opts ports.ReconcileOptions) bool {
+			return opts.DeactivateMissing
+		})).Return(ports.ContentReconciliation{}, nil).Once()
+		mockFeedStateRepo.On("Save", ctx, mock.Anything).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(14), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+
+		run, err := uc.ExecuteForProvider(ctx, pagedProvider)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), run.ItemCount)
+		assert.Equal(t, int32(2), run.UpsertedCount)
+		assert.Equal(t, int32(2), run.StatsCount)
+		mockContentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Truncated Fetch Skips Deactivation", func(t *testing.T) {
+		truncatedProvider := entity.Provider{ID: 7, Code: "provider7", Format: entity.ProviderFormatJSON}
+		items := []ports.ProviderContentItem{{ProviderContentID: "p1", Title: "Title 1"}}
+		mockFeedStateRepo.On("Get", ctx, int64(7)).Return(nil, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, truncatedProvider, entity.FeedValidators{}).Return([][]ports.ProviderContentItem{items}, &ports.FetchResult{
+			Pages:     1,
+			ItemCount: 1,
+			Truncated: true,
+		}, nil).Once()
+
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(7)).Return(int64(1), nil).Once()
+		mockContentRepo.On("GetContentHashes", ctx, int64(7), []string{"p1"}).Return(map[string]string{"p1": contentHash(truncatedProvider, items[0])}, nil).Once()
+		mockContentRepo.On("MarkSeen", ctx, int64(7), []string{"p1"}).Return(nil).Once()
+		mockLogger.On("Warn", "fetch stopped at a feed limit, skipping deactivation", mock.Anything, mock.Anything).Return().Once()
+		mockContentRepo.On("ReconcileProviderContents", ctx, int64(7), []string{"p1"}, mock.MatchedBy(func(opts ports.ReconcileOptions) bool {
+			return !opts.DeactivateMissing
+		})).Return(ports.ContentReconciliation{}, nil).Once()
+		mockFeedStateRepo.On("Save", ctx, mock.Anything).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(15), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := uc.ExecuteForProvider(ctx, truncatedProvider)
+		assert.NoError(t, err)
+		mockContentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Mid-Stream Failure Keeps Nothing", func(t *testing.T) {
+		streamProvider := entity.Provider{ID: 8, Code: "provider8", Format: entity.ProviderFormatJSON}
+		first := []ports.ProviderContentItem{{ProviderContentID: "p1", Title: "Title 1"}}
+		second := []ports.ProviderContentItem{{ProviderContentID: "p2", Title: "Title 2"}}
+		mockFeedStateRepo.On("Get", ctx, int64(8)).Return(nil, nil).Once()
+		mockJsonClient.On("FetchContents", ctx, streamProvider, entity.FeedValidators{}).Return(fetchedPages(first, second)...).Once()
+
+		mockContentRepo.On("CountActiveByProvider", ctx, int64(8)).Return(int64(2), nil).Once()
+		mockContentRepo.On("GetContentHashes", ctx, int64(8), mock.Anything).Return(map[string]string{}, nil).Twice()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64{"p1": 101}, nil).Once()
+		mockContentRepo.On("SaveOrUpdateContents", ctx, mock.Anything).Return(map[string]int64(nil), errors.New("deadlock detected")).Once()
+		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil).Once()
+		mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, []entity.ContentRawPayload{}).Return(nil).Once()
+		mockContentRepo.On("RefreshSearchDocuments", ctx, []int64{101}).Return(nil).Once()
+
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(16), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.MatchedBy(func(run entity.SyncRun) bool {
+			return run.ID == 16 && run.Status == entity.SyncRunStatusFailed && run.UpsertedCount == 0
+		})).Return(nil).Once()
+
+		// Both pages share the sync's transaction, so the first page's
+		// writes are rolled back with the second's.
+		transactions := len(mockTransactor.Calls)
+		run, err := uc.ExecuteForProvider(ctx, streamProvider)
+		assert.Error(t, err)
+		assert.Equal(t, 1, len(mockTransactor.Calls)-transactions)
+		assert.Equal(t, int32(2), run.ItemCount)
+		assert.Equal(t, int32(0), run.UpsertedCount)
+		assert.Equal(t, int32(0), run.StatsCount)
+		mockContentRepo.AssertNotCalled(t, "ReconcileProviderContents", ctx, int64(8), mock.Anything, mock.Anything)
+		mockContentRepo.AssertExpectations(t)
+		mockSyncRunRepo.AssertExpectations(t)
+	})
+}
+
+func TestSyncProviderContentsUseCase_ExecuteAll(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
+
+	uc := NewSyncProviderContentsUseCase(
+		mockProviderRepo,
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{Concurrency: 2, ProviderTimeoutSeconds: 1},
+	)
+
+	ctx := context.Background()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockLogger.On("Warn", "skipping provider, no client reads its format", mock.Anything, mock.Anything).Return().Once()
+	mockSyncRunRepo.On("Create", mock.Anything, mock.Anything).Return(int64(1), nil)
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	mockFeedStateRepo.On("Get", mock.Anything, mock.Anything).Return(nil, nil)
+	mockTransactor.On("WithinTransaction", mock.Anything).Return(nil)
+
+	slow := entity.Provider{ID: 1, Code: "slow", Format: entity.ProviderFormatJSON}
+	fast := entity.Provider{ID: 2, Code: "fast", Format: entity.ProviderFormatJSON}
+	broken := entity.Provider{ID: 3, Code: "broken", Format: entity.ProviderFormatJSON}
+	legacy := entity.Provider{ID: 4, Code: "legacy", Format: "yaml"}
+	mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{slow, fast, broken, legacy}, nil)
+
+	// slow only returns once fast has been fetched, so a serial loop would
+	// block until slow's timeout.
+	fastFetched := make(chan struct{})
+	mockJsonClient.On("FetchContents", mock.Anything, slow, entity.FeedValidators{}).Run(func(args mock.Arguments) {
+		select {
+		case <-fastFetched:
+		case <-args.Get(0).(context.Context).Done():
 		}
-		mockContentRepo.On("SearchContents", ctx, mock.Anything, mock.Anything).Return(savedContents, int64(1), nil)
+	}).Return(nil, &ports.FetchResult{}, nil).Once()
+	mockJsonClient.On("FetchContents", mock.Anything, fast, entity.FeedValidators{}).Run(func(mock.Arguments) {
+		close(fastFetched)
+	}).Return(nil, &ports.FetchResult{}, nil).Once()
+	mockJsonClient.On("FetchContents", mock.Anything, broken, entity.FeedValidators{}).Return(nil, nil, errors.New("connection refused")).Once()
+
+	started := time.Now()
+	result, err := uc.ExecuteAll(ctx)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(started), time.Second)
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 0, result.Skipped)
+	assert.Equal(t, 1, result.Unsupported)
+	assert.Equal(t, []string{"slow", "fast", "broken", "legacy"}, []string{
+		result.Results[0].Provider.Code,
+		result.Results[1].Provider.Code,
+		result.Results[2].Provider.Code,
+		result.Results[3].Provider.Code,
+	})
+	assert.EqualError(t, result.Results[2].Err, "fetch contents: connection refused")
+	assert.ErrorIs(t, result.Results[3].Err, ErrUnsupportedProviderFormat)
+	assert.Nil(t, result.Results[3].Run)
+	mockJsonClient.AssertExpectations(t)
+	mockSyncRunRepo.AssertNumberOfCalls(t, "Create", 3)
+}
+
+func TestSyncProviderContentsUseCase_WritesInBatches(t *testing.T) {
+	mockContentRepo := new(MockContentRepository)
+	mockStatsRepo := new(MockContentStatsRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockRawPayloadRepo := new(MockContentRawPayloadRepository)
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
 
-		mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil)
+	uc := NewSyncProviderContentsUseCase(
+		new(MockProviderRepository),
+		mockContentRepo,
+		mockStatsRepo,
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		mockRawPayloadRepo,
+		mockScoreRepo,
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{BatchSize: 2},
+	)
 
-		mockTagRepo.On("EnsureTags", ctx, mock.Anything).Return([]entity.Tag{{ID: 1, Name: "tag1"}, {ID: 2, Name: "tag2"}}, nil)
-		mockTagRepo.On("AssignToContent", ctx, int64(101), mock.Anything).Return(nil)
+	ctx := context.Background()
+	provider := entity.Provider{ID: 1, Code: "provider1", Format: entity.ProviderFormatJSON}
+	items := []ports.ProviderContentItem{
+		{ProviderContentID: "p1", Title: "Title 1"},
+		{ProviderContentID: "p2", Title: "Title 2"},
+		{ProviderContentID: "p3", Title: "Title 3"},
+	}
+	mockTransactor.On("WithinTransaction", ctx).Return(nil)
+	mockScoreRepo.On("SaveOrUpdateScores", ctx, mock.Anything).Return(nil)
+	mockStatsRepo.On("SaveOrUpdateStats", ctx, mock.Anything).Return(nil)
+	mockRawPayloadRepo.On("SaveOrUpdatePayloads", ctx, mock.Anything).Return(nil)
+	mockContentRepo.On("RefreshSearchDocuments", ctx, mock.Anything).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockFeedStateRepo.On("Get", ctx, int64(1)).Return(nil, nil)
+	mockFeedStateRepo.On("Save", ctx, mock.Anything).Return(nil)
+	mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(1), nil)
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	mockJsonClient.On("FetchContents", ctx, provider, entity.FeedValidators{}).Return(fetchedPages(items)...).Once()
+	mockContentRepo.On("CountActiveByProvider", ctx, int64(1)).Return(int64(3), nil).Once()
+	mockContentRepo.On("GetContentHashes", ctx, int64(1), []string{"p1", "p2"}).Return(map[string]string{}, nil).Once()
+	mockContentRepo.On("GetContentHashes", ctx, int64(1), []string{"p3"}).Return(map[string]string{}, nil).Once()
+	mockContentRepo.On("SaveOrUpdateContents", ctx, mock.MatchedBy(func(contents []entity.Content) bool {
+		return len(contents) == 2
+	})).Return(map[string]int64{"p1": 101, "p2": 102}, nil).Once()
+	mockContentRepo.On("SaveOrUpdateContents", ctx, mock.MatchedBy(func(contents []entity.Content) bool {
+		return len(contents) == 1
+	})).Return(map[string]int64{"p3": 103}, nil).Once()
+	mockContentRepo.On("ReconcileProviderContents", ctx, int64(1), []string{"p1", "p2", "p3"}, mock.Anything).Return(ports.ContentReconciliation{}, nil).Once()
+
+	run, err := uc.ExecuteForProvider(ctx, provider)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), run.ItemCount)
+	assert.Equal(t, int32(3), run.UpsertedCount)
+	mockTransactor.AssertNumberOfCalls(t, "WithinTransaction", 1)
+	mockContentRepo.AssertExpectations(t)
+}
+
+func TestContentHash(t *testing.T) {
+	provider := entity.Provider{ID: 1, Code: "provider1", Format: entity.ProviderFormatJSON}
+	item := ports.ProviderContentItem{ProviderContentID: "p1", Title: "Title 1"}
+	hash := contentHash(provider, item)
 
-		mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	renamed := provider
+	renamed.Name = "Provider 1"
+	assert.Equal(t, hash, contentHash(renamed, item))
+
+	// Items read with another mapping or format are written again, even if
+	// the provider sends the same item.
+	mapped := provider
+	mapped.Mapping = entity.FeedMapping{Items: "$.data", ID: entity.FieldMapping{Path: "$.id"}, Title: entity.FieldMapping{Path: "$.title"}}
+	assert.NotEqual(t, hash, contentHash(mapped, item))
+	reformatted := provider
+	reformatted.Format = entity.ProviderFormatXML
+	assert.NotEqual(t, hash, contentHash(reformatted, item))
+}
 
-		err := uc.ExecuteForProvider(ctx, provider)
+func TestSyncProviderContentsUseCase_FetchErrorsHideSecrets(t *testing.T) {
+	t.Setenv("FEED_SECRET_NEWS_API_KEY", "s3cr3t")
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.Close()
+
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockLogger := new(MockLogger)
+
+	uc := NewSyncProviderContentsUseCase(
+		new(MockProviderRepository),
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: providers.NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{},
+	)
+
+	ctx := context.Background()
+	provider := entity.Provider{
+		ID:      1,
+		Code:    "news",
+		Format:  entity.ProviderFormatJSON,
+		BaseURL: server.URL + "/feed",
+		// A single page retry keeps the test fast.
+		Pagination: entity.FeedPagination{PageRetries: 1},
+		Auth:       entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_NEWS_API_KEY", QueryParam: "api_key"},
+	}
+	mockTransactor.On("WithinTransaction", ctx).Return(nil)
+	mockFeedStateRepo.On("Get", ctx, int64(1)).Return(nil, nil)
+	mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(1), nil)
+	var saved entity.SyncRun
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(entity.SyncRun)
+	}).Return(nil)
+
+	_, err := uc.ExecuteForProvider(ctx, provider)
+	assert.ErrorContains(t, err, server.URL+"/feed")
+	assert.NotContains(t, err.Error(), "s3cr3t")
+	assert.Equal(t, entity.SyncRunStatusFailed, saved.Status)
+	assert.NotContains(t, saved.ErrorMessage, "s3cr3t")
+}
+
+func TestSyncProviderContentsUseCase_ProviderLock(t *testing.T) {
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockLocker := new(MockLocker)
+	mockLogger := new(MockLogger)
+
+	uc := NewSyncProviderContentsUseCase(
+		new(MockProviderRepository),
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		new(MockFeedStateRepository),
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		new(MockTransactor),
+		mockLocker,
+		ProviderClients{entity.ProviderFormatJSON: new(MockProviderClient)},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{},
+	)
+
+	ctx := context.Background()
+	provider := entity.Provider{ID: 4, Code: "provider4", Format: entity.ProviderFormatJSON}
+
+	t.Run("Held By Another Replica", func(t *testing.T) {
+		mockLocker.On("TryLock", mock.Anything, "sync:provider:4").Return(nil, nil).Twice()
+
+		_, err := uc.ExecuteForProvider(ctx, provider)
+		assert.ErrorIs(t, err, ErrSyncInProgress)
+		mockSyncRunRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+		// A triggered run is recorded before the shared lock is tried, so it
+		// is closed as failed instead.
+		finished := make(chan entity.SyncRun, 1)
+		mockLogger.On("Info", mock.Anything, mock.Anything).Return().Once()
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(21), nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			finished <- args.Get(1).(entity.SyncRun)
+		}).Return(nil).Once()
+
+		started, err := uc.StartForProvider(ctx, provider)
 		assert.NoError(t, err)
+		assert.Equal(t, int64(21), started.ID)
+
+		run := <-finished
+		assert.Equal(t, entity.SyncRunStatusFailed, run.Status)
+		assert.Equal(t, ErrSyncInProgress.Error(), run.ErrorMessage)
 	})
+
+	t.Run("Lock Failure", func(t *testing.T) {
+		mockLocker.On("TryLock", ctx, "sync:provider:4").Return(nil, errors.New("connection refused")).Once()
+
+		_, err := uc.ExecuteForProvider(ctx, provider)
+		assert.EqualError(t, err, "lock provider: connection refused")
+	})
+}
+
+// fetchedPages returns the Return arguments of a MockProviderClient fetch
+// handing over pages.
+func fetchedPages(pages ...[]ports.ProviderContentItem) []interface{} {
+	result := &ports.FetchResult{Pages: len(pages)}
+	for _, page := range pages {
+		result.ItemCount += len(page)
+	}
+	return []interface{}{pages, result, nil}
+}
+
+// grantingLocker returns a locker that grants every lock.
+func grantingLocker() *MockLocker {
+	lock := new(MockLock)
+	lock.On("Release", mock.Anything).Return(nil)
+	locker := new(MockLocker)
+	locker.On("TryLock", mock.Anything, mock.Anything).Return(lock, nil)
+	return locker
 }
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+)
+
+// SyncScheduler syncs each enabled provider on its own schedule. Providers
+// are re-read on every pass, so providers added, removed or rescheduled at
+// runtime are picked up without a restart. Each due provider is synced on
+// its own, within the sync concurrency limit, so a slow provider does not
+// hold back the others.
+type SyncScheduler struct {
+	providerRepo ports.ProviderRepository
+	syncUseCase  *SyncProviderContentsUseCase
+	planner      *service.SyncPlanner
+	logger       ports.Logger
+	pollInterval time.Duration
+	now          func() time.Time
+	entries      map[int64]scheduledProvider
+	// finished receives providers whose sync is over, to be rescheduled.
+	finished chan finishedSync
+	syncs    sync.WaitGroup
+}
+
+type scheduledProvider struct {
+	provider entity.Provider
+	next     time.Time
+	// running reports that a sync dispatched for the provider is not over.
+	running bool
+}
+
+type finishedSync struct {
+	providerID int64
+	at         time.Time
+}
+
+func NewSyncScheduler(
+	providerRepo ports.ProviderRepository,
+	syncUseCase *SyncProviderContentsUseCase,
+	planner *service.SyncPlanner,
+	logger ports.Logger,
+	pollInterval time.Duration,
+	now func() time.Time,
+) *SyncScheduler {
+	return &SyncScheduler{
+		providerRepo: providerRepo,
+		syncUseCase:  syncUseCase,
+		planner:      planner,
+		logger:       logger,
+		pollInterval: pollInterval,
+		now:          now,
+		entries:      make(map[int64]scheduledProvider),
+		finished:     make(chan finishedSync),
+	}
+}
+
+// Run syncs providers as they become due until ctx is cancelled, then waits
+// for the syncs it started. It wakes at least every poll interval to pick up
+// provider changes, and whenever a sync finishes to reschedule its provider.
+func (s *SyncScheduler) Run(ctx context.Context) {
+	defer s.syncs.Wait()
+
+	for {
+		wait := min(s.RunDue(ctx).Sub(s.now()), s.pollInterval)
+		timer := time.NewTimer(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case done := <-s.finished:
+			timer.Stop()
+			s.finish(done)
+		case <-timer.C:
+		}
+	}
+}
+
+// RunDue refreshes the schedule, starts a sync for every provider that is due
+// and not already syncing, and returns when the next provider not syncing
+// becomes due. Newly seen providers are due at once. Each finished sync is
+// reported on s.finished.
+func (s *SyncScheduler) RunDue(ctx context.Context) time.Time {
+	s.refresh(ctx)
+
+	now := s.now()
+	due := make([]scheduledProvider, 0, len(s.entries))
+	for _, entry := range s.entries {
+		if !entry.running && !entry.next.After(now) {
+			due = append(due, entry)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].next.Equal(due[j].next) {
+			return due[i].next.Before(due[j].next)
+		}
+		return due[i].provider.Code < due[j].provider.Code
+	})
+
+	for _, entry := range due {
+		entry.running = true
+		s.entries[entry.provider.ID] = entry
+
+		provider := entry.provider
+		s.syncs.Go(func() {
+			s.syncUseCase.executeWithTimeout(ctx, provider, nil)
+			select {
+			case s.finished <- finishedSync{providerID: provider.ID, at: s.now()}:
+			case <-ctx.Done():
+			}
+		})
+	}
+
+	next := now.Add(s.pollInterval)
+	for _, entry := range s.entries {
+		if !entry.running && entry.next.Before(next) {
+			next = entry.next
+		}
+	}
+	return next
+}
+
+// finish plans the next sync of a provider from the end of its last one.
+func (s *SyncScheduler) finish(done finishedSync) {
+	entry, ok := s.entries[done.providerID]
+	if !ok {
+		return
+	}
+	entry.running = false
+	entry.next = s.plan(entry.provider, done.at)
+	s.entries[done.providerID] = entry
+}
+
+// refresh reconciles the schedule with the enabled providers. On error the
+// previous schedule is kept.
+func (s *SyncScheduler) refresh(ctx context.Context) {
+	providers, err := s.providerRepo.GetAllEnabled(ctx)
+	if err != nil {
+		s.logger.Error("failed to refresh sync schedule", loggerPkg.Error(err))
+		return
+	}
+
+	now := s.now()
+	entries := make(map[int64]scheduledProvider, len(providers))
+	for _, provider := range providers {
+		existing, ok := s.entries[provider.ID]
+		switch {
+		case !ok:
+			s.logger.Info("scheduling provider sync", loggerPkg.String("provider_code", provider.Code))
+			entries[provider.ID] = scheduledProvider{provider: provider, next: now}
+		case existing.provider.Schedule != provider.Schedule:
+			s.logger.Info("provider sync schedule changed", loggerPkg.String("provider_code", provider.Code))
+			entries[provider.ID] = scheduledProvider{provider: provider, next: s.plan(provider, now), running: existing.running}
+		default:
+			entries[provider.ID] = scheduledProvider{provider: provider, next: existing.next, running: existing.running}
+		}
+	}
+
+	for id, entry := range s.entries {
+		if _, ok := entries[id]; !ok {
+			s.logger.Info("unscheduling provider sync", loggerPkg.String("provider_code", entry.provider.Code))
+		}
+	}
+	s.entries = entries
+}
+
+// plan returns the provider's next sync time, falling back to the default
+// interval if its schedule cannot be parsed.
+func (s *SyncScheduler) plan(provider entity.Provider, from time.Time) time.Time {
+	next, err := s.planner.Next(provider.Schedule, from)
+	if err == nil {
+		return next
+	}
+
+	s.logger.Warn("invalid sync schedule, using default interval",
+		loggerPkg.String("provider_code", provider.Code),
+		loggerPkg.Error(err))
+	next, _ = s.planner.Next(entity.SyncSchedule{}, from)
+	return next
+}
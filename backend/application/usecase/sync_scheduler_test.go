@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncScheduler_RunDue(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
+
+	syncUseCase := NewSyncProviderContentsUseCase(
+		mockProviderRepo,
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{},
+	)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	scheduler := NewSyncScheduler(
+		mockProviderRepo,
+		syncUseCase,
+		service.NewSyncPlanner(time.Minute, func(time.Duration) time.Duration { return 0 }),
+		mockLogger,
+		30*time.Second,
+		func() time.Time { return now },
+	)
+
+	ctx := context.Background()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockTransactor.On("WithinTransaction", mock.Anything).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockSyncRunRepo.On("Create", mock.Anything, mock.Anything).Return(int64(1), nil)
+	mockFeedStateRepo.On("Get", mock.Anything, mock.Anything).Return(nil, nil)
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	hourly := entity.Provider{ID: 1, Code: "hourly", Format: entity.ProviderFormatJSON, Schedule: entity.SyncSchedule{Interval: time.Hour}}
+	fallback := entity.Provider{ID: 2, Code: "fallback", Format: entity.ProviderFormatJSON}
+	added := entity.Provider{ID: 3, Code: "added", Format: entity.ProviderFormatJSON, Schedule: entity.SyncSchedule{Cron: "*/5 * * * *"}}
+
+	expectSync := func(provider entity.Provider) {
+		mockJsonClient.On("FetchContents", mock.Anything, provider, entity.FeedValidators{}).Return(nil, &ports.FetchResult{}, nil).Once()
+	}
+	// runDue runs one pass and reschedules the syncs it starts as they end.
+	runDue := func(syncs int) time.Time {
+		next := scheduler.RunDue(ctx)
+		for range syncs {
+			scheduler.finish(<-scheduler.finished)
+		}
+		return next
+	}
+
+	t.Run("New Providers Sync At Once", func(t *testing.T) {
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{hourly, fallback}, nil).Once()
+		expectSync(hourly)
+		expectSync(fallback)
+
+		next := runDue(2)
+		assert.Equal(t, now.Add(30*time.Second), next)
+		mockJsonClient.AssertExpectations(t)
+	})
+
+	t.Run("Only Due Providers Sync", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{hourly, fallback}, nil).Once()
+		expectSync(fallback)
+
+		next := runDue(1)
+		assert.Equal(t, now.Add(30*time.Second), next)
+		assert.Equal(t, now.Add(time.Minute), scheduler.entries[fallback.ID].next)
+		assert.Equal(t, now.Add(59*time.Minute), scheduler.entries[hourly.ID].next)
+		mockJsonClient.AssertExpectations(t)
+	})
+
+	t.Run("Picks Up Added And Removed Providers", func(t *testing.T) {
+		now = now.Add(10 * time.Second)
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{hourly, added}, nil).Once()
+		expectSync(added)
+
+		runDue(1)
+		assert.Len(t, scheduler.entries, 2)
+		assert.NotContains(t, scheduler.entries, fallback.ID)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC), scheduler.entries[added.ID].next)
+		mockJsonClient.AssertExpectations(t)
+	})
+
+	t.Run("Rescheduled Provider Is Replanned", func(t *testing.T) {
+		rescheduled := hourly
+		rescheduled.Schedule = entity.SyncSchedule{Interval: 2 * time.Minute}
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{rescheduled, added}, nil).Once()
+
+		runDue(0)
+		assert.Equal(t, now.Add(2*time.Minute), scheduler.entries[hourly.ID].next)
+		mockJsonClient.AssertExpectations(t)
+	})
+}
+
+func TestSyncScheduler_SlowProviderDoesNotHoldBackOthers(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
+
+	syncUseCase := NewSyncProviderContentsUseCase(
+		mockProviderRepo,
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{Concurrency: 2},
+	)
+
+	var mu sync.Mutex
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	scheduler := NewSyncScheduler(
+		mockProviderRepo,
+		syncUseCase,
+		service.NewSyncPlanner(time.Minute, func(time.Duration) time.Duration { return 0 }),
+		mockLogger,
+		30*time.Second,
+		clock,
+	)
+
+	ctx := context.Background()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockTransactor.On("WithinTransaction", mock.Anything).Return(nil)
+	mockSyncRunRepo.On("Create", mock.Anything, mock.Anything).Return(int64(1), nil)
+	mockFeedStateRepo.On("Get", mock.Anything, mock.Anything).Return(nil, nil)
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	slow := entity.Provider{ID: 1, Code: "slow", Format: entity.ProviderFormatJSON}
+	fast := entity.Provider{ID: 2, Code: "fast", Format: entity.ProviderFormatJSON}
+	mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{slow, fast}, nil)
+
+	release := make(chan struct{})
+	mockJsonClient.On("FetchContents", mock.Anything, slow, entity.FeedValidators{}).Run(func(mock.Arguments) {
+		<-release
+	}).Return(nil, &ports.FetchResult{}, nil).Once()
+	mockJsonClient.On("FetchContents", mock.Anything, fast, entity.FeedValidators{}).Return(nil, &ports.FetchResult{}, nil).Twice()
+
+	scheduler.RunDue(ctx)
+	done := <-scheduler.finished
+	assert.Equal(t, fast.ID, done.providerID)
+	scheduler.finish(done)
+	assert.Equal(t, clock().Add(time.Minute), scheduler.entries[fast.ID].next)
+
+	// The fast provider is due again while the slow one is still syncing;
+	// only the fast one is started.
+	mu.Lock()
+	now = now.Add(time.Minute)
+	mu.Unlock()
+	next := scheduler.RunDue(ctx)
+	assert.Equal(t, clock().Add(30*time.Second), next)
+	done = <-scheduler.finished
+	assert.Equal(t, fast.ID, done.providerID)
+	scheduler.finish(done)
+
+	close(release)
+	done = <-scheduler.finished
+	assert.Equal(t, slow.ID, done.providerID)
+	scheduler.finish(done)
+	assert.Equal(t, clock().Add(time.Minute), scheduler.entries[slow.ID].next)
+	mockJsonClient.AssertExpectations(t)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type TriggerSyncRequest struct {
+	// ProviderCode selects a single provider; empty triggers every enabled
+	// provider.
+	ProviderCode string
+}
+
+type TriggerSyncResult struct {
+	Runs []SyncRunWithProvider
+	// Skipped lists providers left alone because a sync was already running.
+	Skipped []entity.Provider
+	// Unsupported lists providers whose format has no client.
+	Unsupported []entity.Provider
+	// Failed lists providers whose sync could not be started, with the reason.
+	Failed []ProviderSyncResult
+}
+
+type TriggerSyncUseCase struct {
+	providerRepo ports.ProviderRepository
+	syncUseCase  *SyncProviderContentsUseCase
+}
+
+func NewTriggerSyncUseCase(
+	providerRepo ports.ProviderRepository,
+	syncUseCase *SyncProviderContentsUseCase,
+) *TriggerSyncUseCase {
+	return &TriggerSyncUseCase{
+		providerRepo: providerRepo,
+		syncUseCase:  syncUseCase,
+	}
+}
+
+// Execute starts syncs in the background and returns their runs, which can be
+// polled until they finish. A single provider is synced even if disabled; a
+// sync already running for it is reported as ErrSyncInProgress, a format no
+// client reads as ErrUnsupportedProviderFormat. When triggering every
+// provider, a provider whose sync cannot be started is reported in Failed and
+// the rest are still started.
+func (uc *TriggerSyncUseCase) Execute(ctx context.Context, req TriggerSyncRequest) (*TriggerSyncResult, error) {
+	if req.ProviderCode != "" {
+		provider, err := uc.providerRepo.GetByCode(ctx, req.ProviderCode)
+		if err != nil {
+			return nil, fmt.Errorf("get provider: %w", err)
+		}
+		if provider == nil {
+			return nil, ErrProviderNotFound
+		}
+
+		run, err := uc.syncUseCase.StartForProvider(ctx, *provider)
+		if err != nil {
+			return nil, err
+		}
+		return &TriggerSyncResult{
+			Runs: []SyncRunWithProvider{{Run: *run, Provider: *provider}},
+		}, nil
+	}
+
+	providers, err := uc.providerRepo.GetAllEnabled(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get all enabled providers: %w", err)
+	}
+
+	result := &TriggerSyncResult{
+		Runs:        make([]SyncRunWithProvider, 0, len(providers)),
+		Skipped:     []entity.Provider{},
+		Unsupported: []entity.Provider{},
+		Failed:      []ProviderSyncResult{},
+	}
+	for _, provider := range providers {
+		run, err := uc.syncUseCase.StartForProvider(ctx, provider)
+		if errors.Is(err, ErrSyncInProgress) {
+			result.Skipped = append(result.Skipped, provider)
+			continue
+		}
+		if errors.Is(err, ErrUnsupportedProviderFormat) {
+			result.Unsupported = append(result.Unsupported, provider)
+			continue
+		}
+		if err != nil {
+			result.Failed = append(result.Failed, ProviderSyncResult{Provider: provider, Err: err})
+			continue
+		}
+		result.Runs = append(result.Runs, SyncRunWithProvider{Run: *run, Provider: provider})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTriggerSyncUseCase_Execute(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
+
+	syncUseCase := NewSyncProviderContentsUseCase(
+		mockProviderRepo,
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{},
+	)
+	uc := NewTriggerSyncUseCase(mockProviderRepo, syncUseCase)
+
+	ctx := context.Background()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockTransactor.On("WithinTransaction", mock.Anything).Return(nil)
+	provider := entity.Provider{ID: 1, Code: "provider1", Format: entity.ProviderFormatJSON, IsEnabled: true}
+	mockFeedStateRepo.On("Get", mock.Anything, int64(1)).Return(nil, nil)
+
+	t.Run("Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := uc.Execute(ctx, TriggerSyncRequest{ProviderCode: "missing"})
+		assert.ErrorIs(t, err, ErrProviderNotFound)
+	})
+
+	t.Run("Overlapping Runs Are Refused", func(t *testing.T) {
+		release := make(chan struct{})
+		finished := make(chan entity.SyncRun, 1)
+		mockProviderRepo.On("GetByCode", ctx, "provider1").Return(&provider, nil)
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{provider}, nil).Once()
+		mockSyncRunRepo.On("Create", ctx, mock.Anything).Return(int64(11), nil).Once()
+		mockJsonClient.On("FetchContents", mock.Anything, provider, entity.FeedValidators{}).Run(func(mock.Arguments) {
+			<-release
+		}).Return(nil, &ports.FetchResult{}, nil).Once()
+		mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			finished <- args.Get(1).(entity.SyncRun)
+		}).Return(nil).Once()
+
+		result, err := uc.Execute(ctx, TriggerSyncRequest{ProviderCode: "provider1"})
+		assert.NoError(t, err)
+		assert.Len(t, result.Runs, 1)
+		assert.Equal(t, int64(11), result.Runs[0].Run.ID)
+		assert.Equal(t, entity.SyncRunStatusRunning, result.Runs[0].Run.Status)
+
+		_, err = uc.Execute(ctx, TriggerSyncRequest{ProviderCode: "provider1"})
+		assert.ErrorIs(t, err, ErrSyncInProgress)
+
+		_, err = syncUseCase.ExecuteForProvider(ctx, provider)
+		assert.ErrorIs(t, err, ErrSyncInProgress)
+
+		all, err := uc.Execute(ctx, TriggerSyncRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, all.Runs)
+		assert.Equal(t, []entity.Provider{provider}, all.Skipped)
+
+		close(release)
+		run := <-finished
+		assert.Equal(t, int64(11), run.ID)
+		assert.Equal(t, entity.SyncRunStatusSuccess, run.Status)
+	})
+
+	t.Run("Unsupported Format", func(t *testing.T) {
+		legacy := entity.Provider{ID: 2, Code: "legacy", Format: "yaml", IsEnabled: true}
+		mockProviderRepo.On("GetByCode", ctx, "legacy").Return(&legacy, nil).Once()
+		mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{legacy}, nil).Once()
+
+		_, err := uc.Execute(ctx, TriggerSyncRequest{ProviderCode: "legacy"})
+		assert.ErrorIs(t, err, ErrUnsupportedProviderFormat)
+
+		all, err := uc.Execute(ctx, TriggerSyncRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, all.Runs)
+		assert.Equal(t, []entity.Provider{legacy}, all.Unsupported)
+	})
+}
+
+func TestTriggerSyncUseCase_ExecuteAll(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockFeedStateRepo := new(MockFeedStateRepository)
+	mockTransactor := new(MockTransactor)
+	mockJsonClient := new(MockProviderClient)
+	mockLogger := new(MockLogger)
+
+	syncUseCase := NewSyncProviderContentsUseCase(
+		mockProviderRepo,
+		new(MockContentRepository),
+		new(MockContentStatsRepository),
+		new(MockTagRepository),
+		mockSyncRunRepo,
+		mockFeedStateRepo,
+		new(MockContentRawPayloadRepository),
+		new(MockContentScoreRepository),
+		mockTransactor,
+		grantingLocker(),
+		ProviderClients{entity.ProviderFormatJSON: mockJsonClient},
+		service.NewTagNormalizer(),
+		service.NewScoringService(entity.ScoringConfig{VideoTypeMultiplier: 1.0}, time.Now),
+		mockLogger,
+		entity.SyncConfig{Concurrency: 1},
+	)
+	uc := NewTriggerSyncUseCase(mockProviderRepo, syncUseCase)
+
+	ctx := context.Background()
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+	mockLogger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mockTransactor.On("WithinTransaction", mock.Anything).Return(nil)
+	mockFeedStateRepo.On("Get", mock.Anything, mock.Anything).Return(nil, nil)
+
+	provider1 := entity.Provider{ID: 1, Code: "provider1", Format: entity.ProviderFormatJSON, IsEnabled: true}
+	provider2 := entity.Provider{ID: 2, Code: "provider2", Format: entity.ProviderFormatJSON, IsEnabled: true}
+	provider3 := entity.Provider{ID: 3, Code: "provider3", Format: entity.ProviderFormatJSON, IsEnabled: true}
+	mockProviderRepo.On("GetAllEnabled", ctx).Return([]entity.Provider{provider1, provider2, provider3}, nil)
+	forProvider := func(id int64) interface{} {
+		return mock.MatchedBy(func(run entity.SyncRun) bool { return run.ProviderID == id })
+	}
+	mockSyncRunRepo.On("Create", ctx, forProvider(1)).Return(int64(11), nil).Once()
+	mockSyncRunRepo.On("Create", ctx, forProvider(2)).Return(int64(0), errors.New("connection refused")).Once()
+	mockSyncRunRepo.On("Create", ctx, forProvider(3)).Return(int64(13), nil).Once()
+
+	release := make(chan struct{})
+	fetching := make(chan string, 2)
+	mockJsonClient.On("FetchContents", mock.Anything, mock.Anything, entity.FeedValidators{}).Run(func(args mock.Arguments) {
+		fetching <- args.Get(1).(entity.Provider).Code
+		<-release
+	}).Return(nil, &ports.FetchResult{}, nil).Twice()
+	finished := make(chan entity.SyncRun, 2)
+	mockSyncRunRepo.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		finished <- args.Get(1).(entity.SyncRun)
+	}).Return(nil).Twice()
+
+	result, err := uc.Execute(ctx, TriggerSyncRequest{})
+	assert.NoError(t, err)
+	if assert.Len(t, result.Runs, 2) {
+		assert.Equal(t, int64(11), result.Runs[0].Run.ID)
+		assert.Equal(t, int64(13), result.Runs[1].Run.ID)
+	}
+	if assert.Len(t, result.Failed, 1) {
+		assert.Equal(t, provider2, result.Failed[0].Provider)
+		assert.EqualError(t, result.Failed[0].Err, "create sync run: connection refused")
+	}
+
+	// Only one sync runs at a time; the other waits for its slot.
+	<-fetching
+	select {
+	case code := <-fetching:
+		t.Fatalf("%s started beyond the concurrency limit", code)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-fetching
+	for range 2 {
+		run := <-finished
+		assert.Equal(t, entity.SyncRunStatusSuccess, run.Status)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 
 	"github.com/mehmetymw/search-aggregation-service/backend/application/usecase"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
 	"github.com/mehmetymw/search-aggregation-service/backend/infrastructure/cache"
 	"github.com/mehmetymw/search-aggregation-service/backend/infrastructure/config"
@@ -72,11 +74,23 @@ func main() {
 	}
 	logger.Info("connected to redis")
 
-	contentRepo := repositories.NewContentRepository(database)
+	contentRepo := repositories.NewContentRepository(database, appConfig.Search.GetLanguage())
 	contentStatsRepo := repositories.NewContentStatsRepository(database)
-	providerRepo := repositories.NewProviderRepository(database)
+	providerRepo := repositories.NewCachedProviderRepository(
+		repositories.NewProviderRepository(database),
+		appConfig.Cache.GetProviderTTL(),
+		time.Now,
+	)
 	tagRepo := repositories.NewTagRepository(database)
 	scoringRepo := repositories.NewScoringRepository(database)
+	syncRunRepo := repositories.NewSyncRunRepository(database)
+	feedStateRepo := repositories.NewFeedStateRepository(database)
+	rawPayloadRepo := repositories.NewContentRawPayloadRepository(database)
+	scoreRepo := repositories.NewContentScoreRepository(database)
+	searchTermRepo := repositories.NewSearchTermRepository(database)
+	metadataRepo := repositories.NewMetadataRepository(database)
+	transactor := repositories.NewTransactor(database)
+	locker := repositories.NewAdvisoryLocker(database)
 
 	dbConfigProvider := config.NewDatabaseConfigProvider(configProvider, scoringRepo)
 
@@ -85,62 +99,159 @@ func main() {
 		return time.Now()
 	}
 	scoringService := service.NewScoringService(scoringConfig, timeProvider)
+	tagNormalizer := service.NewTagNormalizer()
 
 	searchUseCase := usecase.NewSearchContentsUseCase(
 		contentRepo,
 		contentStatsRepo,
+		scoreRepo,
+		searchTermRepo,
+		tagRepo,
+		providerRepo,
 		cacheClient,
 		scoringService,
+		tagNormalizer,
 		logger,
 		appConfig.Cache.GetTTL(),
+		appConfig.Search,
+	)
+
+	autocompleteUseCase := usecase.NewAutocompleteUseCase(
+		contentRepo,
+		tagRepo,
+		cacheClient,
+		logger,
+		appConfig.Cache.GetSuggestTTL(),
 	)
 
 	getByIDUseCase := usecase.NewGetContentByIDUseCase(
 		contentRepo,
 		contentStatsRepo,
+		tagRepo,
+		providerRepo,
 		scoringService,
 	)
 
-	jsonProviderClient := providers.NewJsonProviderClient()
-	xmlProviderClient := providers.NewXmlProviderClient()
-
-	// Wrap provider clients with Circuit Breaker
-	cbConfig := appConfig.CircuitBreaker
-	jsonProviderClientWithCB := resilience.NewCircuitBreakerProviderClient(jsonProviderClient, cbConfig)
-	xmlProviderClientWithCB := resilience.NewCircuitBreakerProviderClient(xmlProviderClient, cbConfig)
+	// Every provider client retries transient failures and is wrapped with
+	// the provider's circuit breaker. Retries sit inside the breaker, which
+	// counts a fetch and its retries as one call.
+	circuitBreakers := resilience.NewProviderCircuitBreakers(appConfig.CircuitBreaker, logger)
+	providerClients := providers.NewBuiltinClientRegistry(appConfig.Sync, appConfig.FeedSecrets,
+		func(_ string, client ports.ProviderClient) ports.ProviderClient {
+			return resilience.NewRetryingProviderClient(client, appConfig.Retry, logger)
+		},
+		func(_ string, client ports.ProviderClient) ports.ProviderClient {
+			return resilience.NewCircuitBreakerProviderClient(client, circuitBreakers)
+		},
+	)
 
-	tagNormalizer := service.NewTagNormalizer()
+	enabledFormats, err := metadataRepo.GetProviderFormats(ctx)
+	if err != nil {
+		logger.Error("failed to load provider formats", loggerPkg.Error(err))
+		os.Exit(1)
+	}
+	if err := providerClients.CheckFormats(enabledFormats); err != nil {
+		logger.Error("enabled provider formats lack a client", loggerPkg.Error(err))
+		os.Exit(1)
+	}
 
 	syncUseCase := usecase.NewSyncProviderContentsUseCase(
 		providerRepo,
 		contentRepo,
 		contentStatsRepo,
 		tagRepo,
-		jsonProviderClientWithCB,
-		xmlProviderClientWithCB,
+		syncRunRepo,
+		feedStateRepo,
+		rawPayloadRepo,
+		scoreRepo,
+		transactor,
+		locker,
+		providerClients,
 		tagNormalizer,
+		scoringService,
 		logger,
+		appConfig.Sync,
 	)
 
-	go startSyncWorker(ctx, syncUseCase, appConfig, logger)
+	recomputeScoresUseCase := usecase.NewRecomputeContentScoresUseCase(
+		scoreRepo,
+		dbConfigProvider,
+		scoringService,
+		logger,
+	)
 
-	metadataRepo := repositories.NewMetadataRepository(database)
+	syncScheduler := usecase.NewSyncScheduler(
+		providerRepo,
+		syncUseCase,
+		service.NewSyncPlanner(appConfig.Sync.GetInterval(), nil),
+		logger,
+		appConfig.Sync.GetPollInterval(),
+		timeProvider,
+	)
+
+	// Every replica scores search results, so each one follows the scoring
+	// rules. Only the replica holding the sync leadership runs the scheduler
+	// and rewrites the persisted scores.
+	rulesChanged := make(chan struct{}, 1)
+	go watchScoringRules(ctx, recomputeScoresUseCase, appConfig, logger, rulesChanged)
+	syncLeaderElection := usecase.NewLeaderElection(locker, "sync:leader", logger, appConfig.Sync.GetLeaderCheckInterval())
+	go syncLeaderElection.Run(ctx, func(ctx context.Context) {
+		var workers sync.WaitGroup
+		workers.Go(func() {
+			startScoreWorker(ctx, recomputeScoresUseCase, appConfig, logger, rulesChanged)
+		})
+		startSyncWorker(ctx, syncScheduler, appConfig, logger)
+		workers.Wait()
+	})
+
+	listSyncRunsUseCase := usecase.NewListSyncRunsUseCase(providerRepo, syncRunRepo)
+	getSyncRunUseCase := usecase.NewGetSyncRunUseCase(providerRepo, syncRunRepo)
+	rawPayloadUseCase := usecase.NewGetContentRawPayloadUseCase(rawPayloadRepo)
+	listTagsUseCase := usecase.NewListTagsUseCase(tagRepo)
+	listProvidersUseCase := usecase.NewListProvidersUseCase(providerRepo)
+
+	saveProviderUseCase := usecase.NewSaveProviderUseCase(providerRepo, metadataRepo, providerClients, appConfig.FeedSecrets)
+	previewMappingUseCase := usecase.NewPreviewProviderMappingUseCase(providerClients, appConfig.FeedSecrets)
+	setProviderEnabledUseCase := usecase.NewSetProviderEnabledUseCase(providerRepo)
+	deleteProviderUseCase := usecase.NewDeleteProviderUseCase(providerRepo)
+	triggerSyncUseCase := usecase.NewTriggerSyncUseCase(providerRepo, syncUseCase)
+	listBreakersUseCase := usecase.NewListCircuitBreakersUseCase(providerRepo, circuitBreakers)
+	resetBreakerUseCase := usecase.NewResetCircuitBreakerUseCase(providerRepo, circuitBreakers)
 
 	// Initialize Rate Limiter
 	rateLimitInterceptor := grpcTransport.NewRateLimitInterceptor(appConfig.RateLimit)
+	apiKeyInterceptor := grpcTransport.NewAPIKeyInterceptor(appConfig.Admin.APIKey, contentpb.ProviderAdminService_ServiceDesc.ServiceName)
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(rateLimitInterceptor.Unary()),
+		grpc.ChainUnaryInterceptor(rateLimitInterceptor.Unary(), apiKeyInterceptor.Unary()),
 	)
 	contentServer := grpcTransport.NewContentServiceServer(
 		searchUseCase,
+		autocompleteUseCase,
 		getByIDUseCase,
+		listSyncRunsUseCase,
+		getSyncRunUseCase,
+		rawPayloadUseCase,
+		listTagsUseCase,
+		listProvidersUseCase,
 		metadataRepo,
 		*appConfig,
 		logger,
 	)
 	contentpb.RegisterContentServiceServer(grpcServer, contentServer)
 
+	providerAdminServer := grpcTransport.NewProviderAdminServer(
+		saveProviderUseCase,
+		setProviderEnabledUseCase,
+		deleteProviderUseCase,
+		triggerSyncUseCase,
+		previewMappingUseCase,
+		listBreakersUseCase,
+		resetBreakerUseCase,
+		logger,
+	)
+	contentpb.RegisterProviderAdminServiceServer(grpcServer, providerAdminServer)
+
 	grpcAddr := fmt.Sprintf(":%d", appConfig.Server.GRPCPort)
 	lis, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
@@ -167,11 +278,17 @@ func main() {
 		}),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-	err = contentpb.RegisterContentServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("localhost:%d", appConfig.Server.GRPCPort), opts)
+	grpcEndpoint := fmt.Sprintf("localhost:%d", appConfig.Server.GRPCPort)
+	err = contentpb.RegisterContentServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
 	if err != nil {
 		logger.Error("failed to register grpc-gateway", loggerPkg.Error(err))
 		os.Exit(1)
 	}
+	err = contentpb.RegisterProviderAdminServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts)
+	if err != nil {
+		logger.Error("failed to register provider admin gateway", loggerPkg.Error(err))
+		os.Exit(1)
+	}
 
 	httpMux := http.NewServeMux()
 	httpMux.Handle("/api/", mux)
@@ -205,28 +322,78 @@ func main() {
 	logger.Info("servers stopped")
 }
 
-func startSyncWorker(ctx context.Context, syncUseCase *usecase.SyncProviderContentsUseCase, config *entity.AppConfig, logger *loggerPkg.ZapLogger) {
-	interval := config.Sync.GetInterval()
-	logger.Info("starting sync worker", loggerPkg.String("interval", interval.String()))
+// startSyncWorker syncs every enabled provider on its own schedule, falling
+// back to the global interval for providers without one.
+func startSyncWorker(ctx context.Context, syncScheduler *usecase.SyncScheduler, config *entity.AppConfig, logger *loggerPkg.ZapLogger) {
+	logger.Info("starting sync worker",
+		loggerPkg.String("default_interval", config.Sync.GetInterval().String()),
+		loggerPkg.String("poll_interval", config.Sync.GetPollInterval().String()))
 
-	syncUseCase.ExecuteAll(ctx)
+	syncScheduler.Run(ctx)
+	logger.Info("sync worker stopped")
+}
 
-	ticker := time.NewTicker(interval)
+// watchScoringRules applies changed scoring rules to this replica and
+// signals rulesChanged, dropping the signal if one is already pending.
+func watchScoringRules(ctx context.Context, recomputeUseCase *usecase.RecomputeContentScoresUseCase, config *entity.AppConfig, logger *loggerPkg.ZapLogger, rulesChanged chan<- struct{}) {
+	ticker := time.NewTicker(config.ScoreRefresh.GetRulesPollInterval())
 	defer ticker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			logger.Info("sync worker stopped")
 			return
 		case <-ticker.C:
-			if err := syncUseCase.ExecuteAll(ctx); err != nil {
-				logger.Error("sync all failed", loggerPkg.Error(err))
+			if !recomputeUseCase.ReloadRules() {
+				continue
+			}
+			logger.Info("scoring rules changed")
+			select {
+			case rulesChanged <- struct{}{}:
+			default:
 			}
 		}
 	}
 }
 
+// startScoreWorker keeps persisted scores current: it recomputes them
+// periodically so recency decays, and immediately when scoring rules change.
+func startScoreWorker(ctx context.Context, recomputeUseCase *usecase.RecomputeContentScoresUseCase, config *entity.AppConfig, logger *loggerPkg.ZapLogger, rulesChanged <-chan struct{}) {
+	interval := config.ScoreRefresh.GetInterval()
+	logger.Info("starting score worker", loggerPkg.String("interval", interval.String()))
+
+	recompute := func() {
+		if _, err := recomputeUseCase.Execute(ctx); err != nil {
+			logger.Error("recompute content scores failed", loggerPkg.Error(err))
+		}
+	}
+
+	// A change signalled before this replica took the lead is covered by the
+	// first recompute.
+	select {
+	case <-rulesChanged:
+	default:
+	}
+	recompute()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("score worker stopped")
+			return
+		case <-ticker.C:
+			recomputeUseCase.ReloadRules()
+			recompute()
+		case <-rulesChanged:
+			logger.Info("recomputing scores for changed scoring rules")
+			recompute()
+		}
+	}
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -13,27 +13,156 @@ import (
 	"github.com/lib/pq"
 )
 
-const countContents = `-- name: CountContents :one
+const countActiveContentsByProvider = `-- name: CountActiveContentsByProvider :one
 SELECT COUNT(*)
 FROM contents
+WHERE provider_id = $1 AND is_active = true
+`
+
+func (q *Queries) CountActiveContentsByProvider(ctx context.Context, providerID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveContentsByProvider, providerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const autocompleteTitles = `-- name: AutocompleteTitles :many
+SELECT c.title
+FROM contents c
+LEFT JOIN content_scores cs ON cs.content_id = c.id
 WHERE
-    is_active = true
-    AND ($1::text IS NULL OR title ILIKE '%' || $1::text || '%')
-    AND ($2::varchar IS NULL OR content_type = $2::varchar)
+    c.is_active = true
+    AND (c.title ILIKE $1::text || '%' OR c.title ILIKE '% ' || $1::text || '%')
+    AND ($2::varchar IS NULL OR c.content_type = $2::varchar)
+GROUP BY c.title
+ORDER BY
+    (c.title ILIKE $1::text || '%') DESC,
+    MAX(COALESCE(cs.final_score, 0)) DESC,
+    c.title
+LIMIT $3
 `
 
-type CountContentsParams struct {
-	Query       sql.NullString `json:"query"`
+type AutocompleteTitlesParams struct {
+	Prefix      string         `json:"prefix"`
 	ContentType sql.NullString `json:"content_type"`
+	LimitCount  int32          `json:"limit_count"`
+}
+
+func (q *Queries) AutocompleteTitles(ctx context.Context, arg AutocompleteTitlesParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, autocompleteTitles, arg.Prefix, arg.ContentType, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		items = append(items, title)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countContents = `-- name: CountContents :one
+SELECT COUNT(*)
+FROM contents c
+LEFT JOIN content_search_documents sd ON sd.content_id = c.id
+WHERE
+    c.is_active = true
+    AND ($1::text IS NULL OR sd.search_vector @@ to_tsquery($2::text::regconfig, $1::text))
+    AND (
+        $3::text IS NULL
+        OR c.title ILIKE '%' || $3::text || '%'
+        OR ($4::bool AND $3::text <% c.title)
+    )
+    AND ($5::varchar IS NULL OR c.content_type = $5::varchar)
+    AND (
+        cardinality($6::text[]) = 0
+        OR (
+            SELECT COUNT(DISTINCT t.name)
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY($6::text[])
+        ) >= CASE WHEN $7::bool THEN cardinality($6::text[]) ELSE 1 END
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM content_tags ct
+        INNER JOIN tags t ON t.id = ct.tag_id
+        WHERE ct.content_id = c.id AND t.name = ANY($8::text[])
+    )
+    AND ($9::timestamp IS NULL OR c.published_at >= $9::timestamp)
+    AND ($10::timestamp IS NULL OR c.published_at < $10::timestamp)
+    AND (cardinality($11::bigint[]) = 0 OR c.provider_id = ANY($11::bigint[]))
+`
+
+type CountContentsParams struct {
+	TsQuery         sql.NullString `json:"ts_query"`
+	Language        string         `json:"language"`
+	Query           sql.NullString `json:"query"`
+	Fuzzy           bool           `json:"fuzzy"`
+	ContentType     sql.NullString `json:"content_type"`
+	IncludeTags     []string       `json:"include_tags"`
+	MatchAllTags    bool           `json:"match_all_tags"`
+	ExcludeTags     []string       `json:"exclude_tags"`
+	PublishedAfter  sql.NullTime   `json:"published_after"`
+	PublishedBefore sql.NullTime   `json:"published_before"`
+	ProviderIds     []int64        `json:"provider_ids"`
 }
 
 func (q *Queries) CountContents(ctx context.Context, arg CountContentsParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countContents, arg.Query, arg.ContentType)
+	row := q.db.QueryRowContext(ctx, countContents,
+		arg.TsQuery,
+		arg.Language,
+		arg.Query,
+		arg.Fuzzy,
+		arg.ContentType,
+		pq.Array(arg.IncludeTags),
+		arg.MatchAllTags,
+		pq.Array(arg.ExcludeTags),
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		pq.Array(arg.ProviderIds),
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const deactivateMissingContents = `-- name: DeactivateMissingContents :execrows
+UPDATE contents
+SET
+    is_active = false,
+    updated_at = NOW()
+WHERE
+    provider_id = $1
+    AND is_active = true
+    AND missed_sync_count >= $2::int
+    AND (last_seen_at IS NULL OR last_seen_at < $3::timestamp)
+`
+
+type DeactivateMissingContentsParams struct {
+	ProviderID     int64     `json:"provider_id"`
+	MinMissedSyncs int32     `json:"min_missed_syncs"`
+	SeenBefore     time.Time `json:"seen_before"`
+}
+
+func (q *Queries) DeactivateMissingContents(ctx context.Context, arg DeactivateMissingContentsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deactivateMissingContents, arg.ProviderID, arg.MinMissedSyncs, arg.SeenBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getContentByID = `-- name: GetContentByID :one
 SELECT 
     id,
@@ -44,7 +173,10 @@ SELECT
     published_at,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    last_seen_at,
+    missed_sync_count,
+    content_hash
 FROM contents
 WHERE id = $1
 `
@@ -62,10 +194,54 @@ func (q *Queries) GetContentByID(ctx context.Context, contentID int64) (Content,
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.LastSeenAt,
+		&i.MissedSyncCount,
+		&i.ContentHash,
 	)
 	return i, err
 }
 
+const getContentHashes = `-- name: GetContentHashes :many
+SELECT provider_content_id, content_hash
+FROM contents
+WHERE
+    provider_id = $1
+    AND provider_content_id = ANY($2::text[])
+`
+
+type GetContentHashesParams struct {
+	ProviderID         int64    `json:"provider_id"`
+	ProviderContentIds []string `json:"provider_content_ids"`
+}
+
+type GetContentHashesRow struct {
+	ProviderContentID string `json:"provider_content_id"`
+	ContentHash       string `json:"content_hash"`
+}
+
+func (q *Queries) GetContentHashes(ctx context.Context, arg GetContentHashesParams) ([]GetContentHashesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getContentHashes, arg.ProviderID, pq.Array(arg.ProviderContentIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetContentHashesRow{}
+	for rows.Next() {
+		var i GetContentHashesRow
+		if err := rows.Scan(&i.ProviderContentID, &i.ContentHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getContentsByIDs = `-- name: GetContentsByIDs :many
 SELECT 
     id,
@@ -76,7 +252,10 @@ SELECT
     published_at,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    last_seen_at,
+    missed_sync_count,
+    content_hash
 FROM contents
 WHERE id = ANY($1::bigint[])
 `
@@ -100,6 +279,9 @@ func (q *Queries) GetContentsByIDs(ctx context.Context, contentIds []int64) ([]C
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LastSeenAt,
+			&i.MissedSyncCount,
+			&i.ContentHash,
 		); err != nil {
 			return nil, err
 		}
@@ -114,37 +296,176 @@ func (q *Queries) GetContentsByIDs(ctx context.Context, contentIds []int64) ([]C
 	return items, nil
 }
 
+const incrementMissedSyncCount = `-- name: IncrementMissedSyncCount :exec
+UPDATE contents
+SET missed_sync_count = missed_sync_count + 1
+WHERE
+    provider_id = $1
+    AND is_active = true
+    AND NOT (provider_content_id = ANY($2::text[]))
+`
+
+type IncrementMissedSyncCountParams struct {
+	ProviderID         int64    `json:"provider_id"`
+	ProviderContentIds []string `json:"provider_content_ids"`
+}
+
+func (q *Queries) IncrementMissedSyncCount(ctx context.Context, arg IncrementMissedSyncCountParams) error {
+	_, err := q.db.ExecContext(ctx, incrementMissedSyncCount, arg.ProviderID, pq.Array(arg.ProviderContentIds))
+	return err
+}
+
+const markContentsSeen = `-- name: MarkContentsSeen :exec
+UPDATE contents
+SET
+    last_seen_at = NOW(),
+    missed_sync_count = 0
+WHERE
+    provider_id = $1
+    AND provider_content_id = ANY($2::text[])
+`
+
+type MarkContentsSeenParams struct {
+	ProviderID         int64    `json:"provider_id"`
+	ProviderContentIds []string `json:"provider_content_ids"`
+}
+
+func (q *Queries) MarkContentsSeen(ctx context.Context, arg MarkContentsSeenParams) error {
+	_, err := q.db.ExecContext(ctx, markContentsSeen, arg.ProviderID, pq.Array(arg.ProviderContentIds))
+	return err
+}
+
+const reactivateSeenContents = `-- name: ReactivateSeenContents :execrows
+UPDATE contents
+SET
+    is_active = true,
+    updated_at = NOW()
+WHERE
+    provider_id = $1
+    AND is_active = false
+    AND provider_content_id = ANY($2::text[])
+`
+
+type ReactivateSeenContentsParams struct {
+	ProviderID         int64    `json:"provider_id"`
+	ProviderContentIds []string `json:"provider_content_ids"`
+}
+
+func (q *Queries) ReactivateSeenContents(ctx context.Context, arg ReactivateSeenContentsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reactivateSeenContents, arg.ProviderID, pq.Array(arg.ProviderContentIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const searchContents = `-- name: SearchContents :many
 SELECT 
-    id,
-    provider_id,
-    provider_content_id,
-    title,
-    content_type,
-    published_at,
-    is_active,
-    created_at,
-    updated_at
-FROM contents
+    c.id,
+    c.provider_id,
+    c.provider_content_id,
+    c.title,
+    c.content_type,
+    c.published_at,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    c.last_seen_at,
+    c.missed_sync_count,
+    r.relevance
+FROM contents c
+LEFT JOIN content_scores cs ON cs.content_id = c.id
+LEFT JOIN content_search_documents sd ON sd.content_id = c.id
+CROSS JOIN LATERAL (
+    SELECT GREATEST(
+        COALESCE(ts_rank(sd.search_vector, to_tsquery($1::text::regconfig, $2::text)), 0),
+        CASE WHEN $3::bool THEN COALESCE(word_similarity($4::text, c.title), 0) ELSE 0 END
+    )::float8 AS relevance
+) r
 WHERE
-    is_active = true
-    AND ($1::text IS NULL OR title ILIKE '%' || $1::text || '%')
-    AND ($2::varchar IS NULL OR content_type = $2::varchar)
-ORDER BY id DESC
-LIMIT $4 OFFSET $3
+    c.is_active = true
+    AND ($2::text IS NULL OR sd.search_vector @@ to_tsquery($1::text::regconfig, $2::text))
+    AND (
+        $4::text IS NULL
+        OR c.title ILIKE '%' || $4::text || '%'
+        OR ($3::bool AND $4::text <% c.title)
+    )
+    AND ($5::varchar IS NULL OR c.content_type = $5::varchar)
+    AND (
+        cardinality($6::text[]) = 0
+        OR (
+            SELECT COUNT(DISTINCT t.name)
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY($6::text[])
+        ) >= CASE WHEN $7::bool THEN cardinality($6::text[]) ELSE 1 END
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM content_tags ct
+        INNER JOIN tags t ON t.id = ct.tag_id
+        WHERE ct.content_id = c.id AND t.name = ANY($8::text[])
+    )
+    AND ($9::timestamp IS NULL OR c.published_at >= $9::timestamp)
+    AND ($10::timestamp IS NULL OR c.published_at < $10::timestamp)
+    AND (cardinality($11::bigint[]) = 0 OR c.provider_id = ANY($11::bigint[]))
+ORDER BY
+    CASE WHEN $12::text = 'score_desc' THEN COALESCE(cs.final_score, 0) + $13::float8 * r.relevance END DESC,
+    CASE WHEN $12::text = 'score_asc' THEN COALESCE(cs.final_score, 0) + $13::float8 * r.relevance END ASC,
+    CASE WHEN $12::text = 'date_desc' THEN c.published_at END DESC,
+    CASE WHEN $12::text = 'date_asc' THEN c.published_at END ASC,
+    c.id DESC
+LIMIT $15 OFFSET $14
 `
 
 type SearchContentsParams struct {
-	Query       sql.NullString `json:"query"`
-	ContentType sql.NullString `json:"content_type"`
-	OffsetCount int32          `json:"offset_count"`
-	LimitCount  int32          `json:"limit_count"`
+	Language        string         `json:"language"`
+	TsQuery         sql.NullString `json:"ts_query"`
+	Fuzzy           bool           `json:"fuzzy"`
+	Query           sql.NullString `json:"query"`
+	ContentType     sql.NullString `json:"content_type"`
+	IncludeTags     []string       `json:"include_tags"`
+	MatchAllTags    bool           `json:"match_all_tags"`
+	ExcludeTags     []string       `json:"exclude_tags"`
+	PublishedAfter  sql.NullTime   `json:"published_after"`
+	PublishedBefore sql.NullTime   `json:"published_before"`
+	ProviderIds     []int64        `json:"provider_ids"`
+	SortOrder       string         `json:"sort_order"`
+	RelevanceWeight float64        `json:"relevance_weight"`
+	OffsetCount     int32          `json:"offset_count"`
+	LimitCount      int32          `json:"limit_count"`
+}
+
+type SearchContentsRow struct {
+	ID                int64        `json:"id"`
+	ProviderID        int64        `json:"provider_id"`
+	ProviderContentID string       `json:"provider_content_id"`
+	Title             string       `json:"title"`
+	ContentType       string       `json:"content_type"`
+	PublishedAt       time.Time    `json:"published_at"`
+	IsActive          bool         `json:"is_active"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+	LastSeenAt        sql.NullTime `json:"last_seen_at"`
+	MissedSyncCount   int32        `json:"missed_sync_count"`
+	Relevance         float64      `json:"relevance"`
 }
 
-func (q *Queries) SearchContents(ctx context.Context, arg SearchContentsParams) ([]Content, error) {
+func (q *Queries) SearchContents(ctx context.Context, arg SearchContentsParams) ([]SearchContentsRow, error) {
 	rows, err := q.db.QueryContext(ctx, searchContents,
+		arg.Language,
+		arg.TsQuery,
+		arg.Fuzzy,
 		arg.Query,
 		arg.ContentType,
+		pq.Array(arg.IncludeTags),
+		arg.MatchAllTags,
+		pq.Array(arg.ExcludeTags),
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		pq.Array(arg.ProviderIds),
+		arg.SortOrder,
+		arg.RelevanceWeight,
 		arg.OffsetCount,
 		arg.LimitCount,
 	)
@@ -152,9 +473,9 @@ func (q *Queries) SearchContents(ctx context.Context, arg SearchContentsParams)
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Content{}
+	items := []SearchContentsRow{}
 	for rows.Next() {
-		var i Content
+		var i SearchContentsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.ProviderID,
@@ -165,6 +486,137 @@ func (q *Queries) SearchContents(ctx context.Context, arg SearchContentsParams)
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.LastSeenAt,
+			&i.MissedSyncCount,
+			&i.Relevance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchFacets = `-- name: SearchFacets :many
+WITH matched AS (
+    SELECT c.id, c.content_type, c.provider_id, c.published_at
+    FROM contents c
+    LEFT JOIN content_search_documents sd ON sd.content_id = c.id
+    WHERE
+        c.is_active = true
+        AND ($1::text IS NULL OR sd.search_vector @@ to_tsquery($2::text::regconfig, $1::text))
+        AND (
+            $3::text IS NULL
+            OR c.title ILIKE '%' || $3::text || '%'
+            OR ($4::bool AND $3::text <% c.title)
+        )
+        AND ($5::varchar IS NULL OR c.content_type = $5::varchar)
+        AND (
+            cardinality($6::text[]) = 0
+            OR (
+                SELECT COUNT(DISTINCT t.name)
+                FROM content_tags ct
+                INNER JOIN tags t ON t.id = ct.tag_id
+                WHERE ct.content_id = c.id AND t.name = ANY($6::text[])
+            ) >= CASE WHEN $7::bool THEN cardinality($6::text[]) ELSE 1 END
+        )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY($8::text[])
+        )
+        AND ($9::timestamp IS NULL OR c.published_at >= $9::timestamp)
+        AND ($10::timestamp IS NULL OR c.published_at < $10::timestamp)
+        AND (cardinality($11::bigint[]) = 0 OR c.provider_id = ANY($11::bigint[]))
+),
+tag_counts AS (
+    SELECT t.name, COUNT(*)::bigint AS count
+    FROM matched m
+    INNER JOIN content_tags ct ON ct.content_id = m.id
+    INNER JOIN tags t ON t.id = ct.tag_id
+    GROUP BY t.name
+    ORDER BY count DESC, t.name
+    LIMIT $12
+)
+SELECT 'content_type'::text AS facet, m.content_type::text AS value, m.content_type::text AS label, COUNT(*)::bigint AS count
+FROM matched m
+GROUP BY m.content_type
+UNION ALL
+SELECT 'provider'::text, p.code::text, p.name::text, COUNT(*)::bigint
+FROM matched m
+INNER JOIN providers p ON p.id = m.provider_id
+GROUP BY p.code, p.name
+UNION ALL
+SELECT 'tag'::text, tc.name::text, tc.name::text, tc.count
+FROM tag_counts tc
+UNION ALL
+SELECT 'published_at'::text, b.bucket, b.bucket, COUNT(m.id)::bigint
+FROM (VALUES
+    ('past_day', INTERVAL '1 day'),
+    ('past_week', INTERVAL '7 days'),
+    ('past_month', INTERVAL '30 days'),
+    ('past_year', INTERVAL '365 days')
+) AS b(bucket, span)
+LEFT JOIN matched m ON m.published_at >= NOW() - b.span
+GROUP BY b.bucket
+`
+
+type SearchFacetsParams struct {
+	TsQuery         sql.NullString `json:"ts_query"`
+	Language        string         `json:"language"`
+	Query           sql.NullString `json:"query"`
+	Fuzzy           bool           `json:"fuzzy"`
+	ContentType     sql.NullString `json:"content_type"`
+	IncludeTags     []string       `json:"include_tags"`
+	MatchAllTags    bool           `json:"match_all_tags"`
+	ExcludeTags     []string       `json:"exclude_tags"`
+	PublishedAfter  sql.NullTime   `json:"published_after"`
+	PublishedBefore sql.NullTime   `json:"published_before"`
+	ProviderIds     []int64        `json:"provider_ids"`
+	TagLimit        int32          `json:"tag_limit"`
+}
+
+type SearchFacetsRow struct {
+	Facet string `json:"facet"`
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+func (q *Queries) SearchFacets(ctx context.Context, arg SearchFacetsParams) ([]SearchFacetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchFacets,
+		arg.TsQuery,
+		arg.Language,
+		arg.Query,
+		arg.Fuzzy,
+		arg.ContentType,
+		pq.Array(arg.IncludeTags),
+		arg.MatchAllTags,
+		pq.Array(arg.ExcludeTags),
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		pq.Array(arg.ProviderIds),
+		arg.TagLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchFacetsRow{}
+	for rows.Next() {
+		var i SearchFacetsRow
+		if err := rows.Scan(
+			&i.Facet,
+			&i.Value,
+			&i.Label,
+			&i.Count,
 		); err != nil {
 			return nil, err
 		}
@@ -187,6 +639,9 @@ INSERT INTO contents (
     content_type,
     published_at,
     is_active,
+    last_seen_at,
+    missed_sync_count,
+    content_hash,
     updated_at
 ) VALUES (
     $1,
@@ -195,6 +650,9 @@ INSERT INTO contents (
     $4,
     $5,
     $6,
+    NOW(),
+    0,
+    $7,
     NOW()
 )
 ON CONFLICT (provider_id, provider_content_id)
@@ -202,7 +660,9 @@ DO UPDATE SET
     title = EXCLUDED.title,
     content_type = EXCLUDED.content_type,
     published_at = EXCLUDED.published_at,
-    is_active = EXCLUDED.is_active,
+    last_seen_at = NOW(),
+    missed_sync_count = 0,
+    content_hash = EXCLUDED.content_hash,
     updated_at = NOW()
 RETURNING id
 `
@@ -214,6 +674,7 @@ type UpsertContentParams struct {
 	ContentType       string    `json:"content_type"`
 	PublishedAt       time.Time `json:"published_at"`
 	IsActive          bool      `json:"is_active"`
+	ContentHash       string    `json:"content_hash"`
 }
 
 func (q *Queries) UpsertContent(ctx context.Context, arg UpsertContentParams) (int64, error) {
@@ -224,8 +685,41 @@ func (q *Queries) UpsertContent(ctx context.Context, arg UpsertContentParams) (i
 		arg.ContentType,
 		arg.PublishedAt,
 		arg.IsActive,
+		arg.ContentHash,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
+
+const upsertContentSearchDocuments = `-- name: UpsertContentSearchDocuments :exec
+INSERT INTO content_search_documents (
+    content_id,
+    search_vector,
+    updated_at
+)
+SELECT
+    c.id,
+    setweight(to_tsvector($1::text::regconfig, c.title), 'A') ||
+        setweight(to_tsvector($1::text::regconfig, COALESCE(string_agg(t.name, ' '), '')), 'B'),
+    NOW()
+FROM contents c
+LEFT JOIN content_tags ct ON ct.content_id = c.id
+LEFT JOIN tags t ON t.id = ct.tag_id
+WHERE c.id = ANY($2::bigint[])
+GROUP BY c.id, c.title
+ON CONFLICT (content_id)
+DO UPDATE SET
+    search_vector = EXCLUDED.search_vector,
+    updated_at = NOW()
+`
+
+type UpsertContentSearchDocumentsParams struct {
+	Language   string  `json:"language"`
+	ContentIds []int64 `json:"content_ids"`
+}
+
+func (q *Queries) UpsertContentSearchDocuments(ctx context.Context, arg UpsertContentSearchDocumentsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertContentSearchDocuments, arg.Language, pq.Array(arg.ContentIds))
+	return err
+}
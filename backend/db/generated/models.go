@@ -11,15 +11,18 @@ import (
 )
 
 type Content struct {
-	ID                int64     `json:"id"`
-	ProviderID        int64     `json:"provider_id"`
-	ProviderContentID string    `json:"provider_content_id"`
-	Title             string    `json:"title"`
-	ContentType       string    `json:"content_type"`
-	PublishedAt       time.Time `json:"published_at"`
-	IsActive          bool      `json:"is_active"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                int64        `json:"id"`
+	ProviderID        int64        `json:"provider_id"`
+	ProviderContentID string       `json:"provider_content_id"`
+	Title             string       `json:"title"`
+	ContentType       string       `json:"content_type"`
+	PublishedAt       time.Time    `json:"published_at"`
+	IsActive          bool         `json:"is_active"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+	LastSeenAt        sql.NullTime `json:"last_seen_at"`
+	MissedSyncCount   int32        `json:"missed_sync_count"`
+	ContentHash       string       `json:"content_hash"`
 }
 
 type ContentRawPayload struct {
@@ -29,6 +32,22 @@ type ContentRawPayload struct {
 	FetchedAt  time.Time       `json:"fetched_at"`
 }
 
+type ContentScore struct {
+	ContentID       int64     `json:"content_id"`
+	BaseScore       float64   `json:"base_score"`
+	TypeMultiplier  float64   `json:"type_multiplier"`
+	RecencyScore    float64   `json:"recency_score"`
+	EngagementScore float64   `json:"engagement_score"`
+	FinalScore      float64   `json:"final_score"`
+	ComputedAt      time.Time `json:"computed_at"`
+}
+
+type ContentSearchDocument struct {
+	ContentID    int64       `json:"content_id"`
+	SearchVector interface{} `json:"search_vector"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
 type ContentStat struct {
 	ID          int64     `json:"id"`
 	ContentID   int64     `json:"content_id"`
@@ -55,25 +74,47 @@ type ContentTypeMetadatum struct {
 }
 
 type Provider struct {
-	ID        int64       `json:"id"`
-	Name      string      `json:"name"`
-	Code      string      `json:"code"`
-	Format    interface{} `json:"format"`
-	BaseUrl   string      `json:"base_url"`
-	IsEnabled bool        `json:"is_enabled"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
+	ID                  int64           `json:"id"`
+	Name                string          `json:"name"`
+	Code                string          `json:"code"`
+	Format              interface{}     `json:"format"`
+	BaseUrl             string          `json:"base_url"`
+	IsEnabled           bool            `json:"is_enabled"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+	SyncIntervalSeconds int32           `json:"sync_interval_seconds"`
+	SyncCron            string          `json:"sync_cron"`
+	SyncJitterSeconds   int32           `json:"sync_jitter_seconds"`
+	FeedPagination      json.RawMessage `json:"feed_pagination"`
+	FeedMapping         json.RawMessage `json:"feed_mapping"`
+	FeedAuth            json.RawMessage `json:"feed_auth"`
+}
+
+type ProviderFeedState struct {
+	ProviderID   int64     `json:"provider_id"`
+	FeedUrl      string    `json:"feed_url"`
+	Etag         string    `json:"etag"`
+	LastModified string    `json:"last_modified"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
 
 type ProviderSyncRun struct {
-	ID           int64          `json:"id"`
-	ProviderID   int64          `json:"provider_id"`
-	StartedAt    time.Time      `json:"started_at"`
-	FinishedAt   sql.NullTime   `json:"finished_at"`
-	Status       string         `json:"status"`
-	ItemCount    int32          `json:"item_count"`
-	ErrorMessage sql.NullString `json:"error_message"`
-	CreatedAt    time.Time      `json:"created_at"`
+	ID               int64          `json:"id"`
+	ProviderID       int64          `json:"provider_id"`
+	StartedAt        time.Time      `json:"started_at"`
+	FinishedAt       sql.NullTime   `json:"finished_at"`
+	Status           string         `json:"status"`
+	ItemCount        int32          `json:"item_count"`
+	ErrorMessage     sql.NullString `json:"error_message"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpsertedCount    int32          `json:"upserted_count"`
+	StatsCount       int32          `json:"stats_count"`
+	TagsCount        int32          `json:"tags_count"`
+	SkippedCount     int32          `json:"skipped_count"`
+	DeactivatedCount int32          `json:"deactivated_count"`
+	ReactivatedCount int32          `json:"reactivated_count"`
+	UnchangedCount   int32          `json:"unchanged_count"`
+	NotModified      bool           `json:"not_modified"`
 }
 
 type ScoringRule struct {
@@ -83,6 +124,11 @@ type ScoringRule struct {
 	UpdatedAt   sql.NullTime    `json:"updated_at"`
 }
 
+type SearchTerm struct {
+	Term      string    `json:"term"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type Tag struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
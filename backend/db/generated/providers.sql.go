@@ -7,10 +7,23 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 )
 
+const deleteProvider = `-- name: DeleteProvider :exec
+DELETE FROM providers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteProvider(ctx context.Context, providerID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteProvider, providerID)
+	return err
+}
+
 const getAllEnabledProviders = `-- name: GetAllEnabledProviders :many
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE is_enabled = true
 `
@@ -33,6 +46,12 @@ func (q *Queries) GetAllEnabledProviders(ctx context.Context) ([]Provider, error
 			&i.IsEnabled,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SyncIntervalSeconds,
+			&i.SyncCron,
+			&i.SyncJitterSeconds,
+			&i.FeedPagination,
+			&i.FeedMapping,
+			&i.FeedAuth,
 		); err != nil {
 			return nil, err
 		}
@@ -48,7 +67,9 @@ func (q *Queries) GetAllEnabledProviders(ctx context.Context) ([]Provider, error
 }
 
 const getProviderByCode = `-- name: GetProviderByCode :one
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE code = $1
 `
@@ -65,12 +86,20 @@ func (q *Queries) GetProviderByCode(ctx context.Context, code string) (Provider,
 		&i.IsEnabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SyncIntervalSeconds,
+		&i.SyncCron,
+		&i.SyncJitterSeconds,
+		&i.FeedPagination,
+		&i.FeedMapping,
+		&i.FeedAuth,
 	)
 	return i, err
 }
 
 const getProviderByID = `-- name: GetProviderByID :one
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE id = $1
 `
@@ -87,23 +116,100 @@ func (q *Queries) GetProviderByID(ctx context.Context, providerID int64) (Provid
 		&i.IsEnabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SyncIntervalSeconds,
+		&i.SyncCron,
+		&i.SyncJitterSeconds,
+		&i.FeedPagination,
+		&i.FeedMapping,
+		&i.FeedAuth,
 	)
 	return i, err
 }
 
+const listProviders = `-- name: ListProviders :many
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
+FROM providers
+ORDER BY name, code
+`
+
+func (q *Queries) ListProviders(ctx context.Context) ([]Provider, error) {
+	rows, err := q.db.QueryContext(ctx, listProviders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Provider{}
+	for rows.Next() {
+		var i Provider
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Code,
+			&i.Format,
+			&i.BaseUrl,
+			&i.IsEnabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SyncIntervalSeconds,
+			&i.SyncCron,
+			&i.SyncJitterSeconds,
+			&i.FeedPagination,
+			&i.FeedMapping,
+			&i.FeedAuth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const providerHasContents = `-- name: ProviderHasContents :one
+SELECT EXISTS (
+    SELECT 1 FROM contents WHERE provider_id = $1
+) AS has_contents
+`
+
+func (q *Queries) ProviderHasContents(ctx context.Context, providerID int64) (bool, error) {
+	row := q.db.QueryRowContext(ctx, providerHasContents, providerID)
+	var has_contents bool
+	err := row.Scan(&has_contents)
+	return has_contents, err
+}
+
 const upsertProvider = `-- name: UpsertProvider :exec
 INSERT INTO providers (
     name,
     code,
     format,
     base_url,
-    is_enabled
+    is_enabled,
+    sync_interval_seconds,
+    sync_cron,
+    sync_jitter_seconds,
+    feed_pagination,
+    feed_mapping,
+    feed_auth
 ) VALUES (
     $1,
     $2,
     $3,
     $4,
-    $5
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11
 )
 ON CONFLICT (code)
 DO UPDATE SET
@@ -111,15 +217,27 @@ DO UPDATE SET
     format = EXCLUDED.format,
     base_url = EXCLUDED.base_url,
     is_enabled = EXCLUDED.is_enabled,
+    sync_interval_seconds = EXCLUDED.sync_interval_seconds,
+    sync_cron = EXCLUDED.sync_cron,
+    sync_jitter_seconds = EXCLUDED.sync_jitter_seconds,
+    feed_pagination = EXCLUDED.feed_pagination,
+    feed_mapping = EXCLUDED.feed_mapping,
+    feed_auth = EXCLUDED.feed_auth,
     updated_at = NOW()
 `
 
 type UpsertProviderParams struct {
-	Name      string      `json:"name"`
-	Code      string      `json:"code"`
-	Format    interface{} `json:"format"`
-	BaseUrl   string      `json:"base_url"`
-	IsEnabled bool        `json:"is_enabled"`
+	Name                string          `json:"name"`
+	Code                string          `json:"code"`
+	Format              interface{}     `json:"format"`
+	BaseUrl             string          `json:"base_url"`
+	IsEnabled           bool            `json:"is_enabled"`
+	SyncIntervalSeconds int32           `json:"sync_interval_seconds"`
+	SyncCron            string          `json:"sync_cron"`
+	SyncJitterSeconds   int32           `json:"sync_jitter_seconds"`
+	FeedPagination      json.RawMessage `json:"feed_pagination"`
+	FeedMapping         json.RawMessage `json:"feed_mapping"`
+	FeedAuth            json.RawMessage `json:"feed_auth"`
 }
 
 func (q *Queries) UpsertProvider(ctx context.Context, arg UpsertProviderParams) error {
@@ -129,6 +247,12 @@ func (q *Queries) UpsertProvider(ctx context.Context, arg UpsertProviderParams)
 		arg.Format,
 		arg.BaseUrl,
 		arg.IsEnabled,
+		arg.SyncIntervalSeconds,
+		arg.SyncCron,
+		arg.SyncJitterSeconds,
+		arg.FeedPagination,
+		arg.FeedMapping,
+		arg.FeedAuth,
 	)
 	return err
 }
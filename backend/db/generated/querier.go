@@ -11,26 +11,58 @@ import (
 
 type Querier interface {
 	AssignTagToContent(ctx context.Context, arg AssignTagToContentParams) error
+	AutocompleteTags(ctx context.Context, arg AutocompleteTagsParams) ([]string, error)
+	AutocompleteTitles(ctx context.Context, arg AutocompleteTitlesParams) ([]string, error)
+	CountActiveContentsByProvider(ctx context.Context, providerID int64) (int64, error)
 	CountContents(ctx context.Context, arg CountContentsParams) (int64, error)
+	CreateSyncRun(ctx context.Context, arg CreateSyncRunParams) (int64, error)
+	DeactivateMissingContents(ctx context.Context, arg DeactivateMissingContentsParams) (int64, error)
+	DeleteProvider(ctx context.Context, providerID int64) error
+	DeleteSyncRunsByProvider(ctx context.Context, providerID int64) error
 	EnsureTag(ctx context.Context, name string) (Tag, error)
 	GetAllContentTypeMetadata(ctx context.Context) ([]ContentTypeMetadatum, error)
 	GetAllEnabledProviders(ctx context.Context) ([]Provider, error)
 	GetContentByID(ctx context.Context, contentID int64) (Content, error)
+	GetContentHashes(ctx context.Context, arg GetContentHashesParams) ([]GetContentHashesRow, error)
+	GetContentRawPayloadByContentID(ctx context.Context, contentID int64) (ContentRawPayload, error)
+	GetContentScoresByIDs(ctx context.Context, contentIds []int64) ([]ContentScore, error)
 	GetContentStatsByID(ctx context.Context, contentID int64) (GetContentStatsByIDRow, error)
 	GetContentStatsByIDs(ctx context.Context, contentIds []int64) ([]GetContentStatsByIDsRow, error)
 	GetContentTypeMetadataByID(ctx context.Context, id string) (ContentTypeMetadatum, error)
 	GetContentsByIDs(ctx context.Context, contentIds []int64) ([]Content, error)
+	GetEnabledProviderFormats(ctx context.Context) ([]string, error)
 	GetProviderByCode(ctx context.Context, code string) (Provider, error)
 	GetProviderByID(ctx context.Context, providerID int64) (Provider, error)
+	GetProviderFeedState(ctx context.Context, providerID int64) (ProviderFeedState, error)
 	GetScoringRule(ctx context.Context, key string) (json.RawMessage, error)
 	GetScoringRules(ctx context.Context) ([]GetScoringRulesRow, error)
+	GetSyncRunByID(ctx context.Context, id int64) (ProviderSyncRun, error)
 	GetTagsByContentID(ctx context.Context, contentID int64) ([]Tag, error)
+	GetTagsByContentIDs(ctx context.Context, contentIds []int64) ([]GetTagsByContentIDsRow, error)
+	IncrementMissedSyncCount(ctx context.Context, arg IncrementMissedSyncCountParams) error
+	ListContentsForScoring(ctx context.Context, arg ListContentsForScoringParams) ([]ListContentsForScoringRow, error)
+	ListProviders(ctx context.Context) ([]Provider, error)
+	ListSyncRunsByProvider(ctx context.Context, arg ListSyncRunsByProviderParams) ([]ProviderSyncRun, error)
+	ListTagsWithCounts(ctx context.Context, arg ListTagsWithCountsParams) ([]ListTagsWithCountsRow, error)
+	MarkContentsSeen(ctx context.Context, arg MarkContentsSeenParams) error
+	ProviderHasContents(ctx context.Context, providerID int64) (bool, error)
+	ReactivateSeenContents(ctx context.Context, arg ReactivateSeenContentsParams) (int64, error)
+	ReleaseAdvisoryLock(ctx context.Context, lockKey string) (bool, error)
 	RemoveContentTags(ctx context.Context, contentID int64) error
-	SearchContents(ctx context.Context, arg SearchContentsParams) ([]Content, error)
+	SearchContents(ctx context.Context, arg SearchContentsParams) ([]SearchContentsRow, error)
+	SearchFacets(ctx context.Context, arg SearchFacetsParams) ([]SearchFacetsRow, error)
+	SuggestSearchTerms(ctx context.Context, arg SuggestSearchTermsParams) ([]SuggestSearchTermsRow, error)
+	TryAdvisoryLock(ctx context.Context, lockKey string) (bool, error)
+	UpdateSyncRun(ctx context.Context, arg UpdateSyncRunParams) error
 	UpsertContent(ctx context.Context, arg UpsertContentParams) (int64, error)
+	UpsertContentRawPayload(ctx context.Context, arg UpsertContentRawPayloadParams) error
+	UpsertContentScore(ctx context.Context, arg UpsertContentScoreParams) error
+	UpsertContentSearchDocuments(ctx context.Context, arg UpsertContentSearchDocumentsParams) error
 	UpsertContentStats(ctx context.Context, arg UpsertContentStatsParams) error
 	UpsertProvider(ctx context.Context, arg UpsertProviderParams) error
+	UpsertProviderFeedState(ctx context.Context, arg UpsertProviderFeedStateParams) error
 	UpsertScoringRule(ctx context.Context, arg UpsertScoringRuleParams) error
+	UpsertSearchTerms(ctx context.Context, contentIds []int64) error
 }
 
 var _ Querier = (*Queries)(nil)
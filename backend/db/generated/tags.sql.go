@@ -7,6 +7,9 @@ package db
 
 import (
 	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
 )
 
 const assignTagToContent = `-- name: AssignTagToContent :exec
@@ -25,6 +28,49 @@ func (q *Queries) AssignTagToContent(ctx context.Context, arg AssignTagToContent
 	return err
 }
 
+const autocompleteTags = `-- name: AutocompleteTags :many
+SELECT t.name
+FROM tags t
+INNER JOIN content_tags ct ON ct.tag_id = t.id
+INNER JOIN contents c ON c.id = ct.content_id
+WHERE
+    t.name LIKE $1::text || '%'
+    AND c.is_active = true
+    AND ($2::varchar IS NULL OR c.content_type = $2::varchar)
+GROUP BY t.name
+ORDER BY COUNT(*) DESC, t.name
+LIMIT $3
+`
+
+type AutocompleteTagsParams struct {
+	Prefix      string         `json:"prefix"`
+	ContentType sql.NullString `json:"content_type"`
+	LimitCount  int32          `json:"limit_count"`
+}
+
+func (q *Queries) AutocompleteTags(ctx context.Context, arg AutocompleteTagsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, autocompleteTags, arg.Prefix, arg.ContentType, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const ensureTag = `-- name: EnsureTag :one
 INSERT INTO tags (name)
 VALUES ($1)
@@ -70,6 +116,90 @@ func (q *Queries) GetTagsByContentID(ctx context.Context, contentID int64) ([]Ta
 	return items, nil
 }
 
+const getTagsByContentIDs = `-- name: GetTagsByContentIDs :many
+SELECT ct.content_id, t.id, t.name
+FROM content_tags ct
+INNER JOIN tags t ON t.id = ct.tag_id
+WHERE ct.content_id = ANY($1::bigint[])
+ORDER BY ct.content_id, t.name
+`
+
+type GetTagsByContentIDsRow struct {
+	ContentID int64  `json:"content_id"`
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+}
+
+func (q *Queries) GetTagsByContentIDs(ctx context.Context, contentIds []int64) ([]GetTagsByContentIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTagsByContentIDs, pq.Array(contentIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTagsByContentIDsRow{}
+	for rows.Next() {
+		var i GetTagsByContentIDsRow
+		if err := rows.Scan(&i.ContentID, &i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTagsWithCounts = `-- name: ListTagsWithCounts :many
+SELECT t.id, t.name, COUNT(*)::bigint AS content_count
+FROM tags t
+INNER JOIN content_tags ct ON ct.tag_id = t.id
+INNER JOIN contents c ON c.id = ct.content_id
+WHERE
+    c.is_active = true
+    AND ($1::varchar IS NULL OR c.content_type = $1::varchar)
+GROUP BY t.id, t.name
+ORDER BY content_count DESC, t.name
+LIMIT $2
+`
+
+type ListTagsWithCountsParams struct {
+	ContentType sql.NullString `json:"content_type"`
+	LimitCount  int32          `json:"limit_count"`
+}
+
+type ListTagsWithCountsRow struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	ContentCount int64  `json:"content_count"`
+}
+
+func (q *Queries) ListTagsWithCounts(ctx context.Context, arg ListTagsWithCountsParams) ([]ListTagsWithCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTagsWithCounts, arg.ContentType, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTagsWithCountsRow{}
+	for rows.Next() {
+		var i ListTagsWithCountsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.ContentCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const removeContentTags = `-- name: RemoveContentTags :exec
 DELETE FROM content_tags
 WHERE content_id = $1
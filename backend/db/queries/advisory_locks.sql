@@ -0,0 +1,5 @@
+-- name: TryAdvisoryLock :one
+SELECT pg_try_advisory_lock(hashtextextended(sqlc.arg(lock_key)::text, 0)) AS acquired;
+
+-- name: ReleaseAdvisoryLock :one
+SELECT pg_advisory_unlock(hashtextextended(sqlc.arg(lock_key)::text, 0)) AS released;
@@ -0,0 +1,22 @@
+-- name: UpsertContentRawPayload :exec
+INSERT INTO content_raw_payloads (
+    content_id,
+    provider_id,
+    raw_payload,
+    fetched_at
+) VALUES (
+    sqlc.arg(content_id),
+    sqlc.arg(provider_id),
+    sqlc.arg(raw_payload),
+    sqlc.arg(fetched_at)
+)
+ON CONFLICT (content_id)
+DO UPDATE SET
+    provider_id = EXCLUDED.provider_id,
+    raw_payload = EXCLUDED.raw_payload,
+    fetched_at = EXCLUDED.fetched_at;
+
+-- name: GetContentRawPayloadByContentID :one
+SELECT content_id, provider_id, raw_payload, fetched_at
+FROM content_raw_payloads
+WHERE content_id = sqlc.arg(content_id);
@@ -0,0 +1,55 @@
+-- name: UpsertContentScore :exec
+INSERT INTO content_scores (
+    content_id,
+    base_score,
+    type_multiplier,
+    recency_score,
+    engagement_score,
+    final_score,
+    computed_at
+) VALUES (
+    sqlc.arg(content_id),
+    sqlc.arg(base_score),
+    sqlc.arg(type_multiplier),
+    sqlc.arg(recency_score),
+    sqlc.arg(engagement_score),
+    sqlc.arg(final_score),
+    sqlc.arg(computed_at)
+)
+ON CONFLICT (content_id)
+DO UPDATE SET
+    base_score = EXCLUDED.base_score,
+    type_multiplier = EXCLUDED.type_multiplier,
+    recency_score = EXCLUDED.recency_score,
+    engagement_score = EXCLUDED.engagement_score,
+    final_score = EXCLUDED.final_score,
+    computed_at = EXCLUDED.computed_at;
+
+-- name: GetContentScoresByIDs :many
+SELECT
+    content_id,
+    base_score,
+    type_multiplier,
+    recency_score,
+    engagement_score,
+    final_score,
+    computed_at
+FROM content_scores
+WHERE content_id = ANY(sqlc.arg(content_ids)::bigint[]);
+
+-- name: ListContentsForScoring :many
+SELECT
+    c.id,
+    c.content_type,
+    c.published_at,
+    COALESCE(s.views, 0)::bigint AS views,
+    COALESCE(s.likes, 0)::bigint AS likes,
+    COALESCE(s.duration_sec, 0)::int AS duration_sec,
+    COALESCE(s.reading_time, 0)::int AS reading_time,
+    COALESCE(s.reactions, 0)::bigint AS reactions,
+    COALESCE(s.comments, 0)::bigint AS comments
+FROM contents c
+LEFT JOIN content_stats s ON s.content_id = c.id
+WHERE c.is_active = true AND c.id > sqlc.arg(after_id)
+ORDER BY c.id
+LIMIT sqlc.arg(limit_count);
@@ -6,6 +6,9 @@ INSERT INTO contents (
     content_type,
     published_at,
     is_active,
+    last_seen_at,
+    missed_sync_count,
+    content_hash,
     updated_at
 ) VALUES (
     sqlc.arg(provider_id),
@@ -14,6 +17,9 @@ INSERT INTO contents (
     sqlc.arg(content_type),
     sqlc.arg(published_at),
     sqlc.arg(is_active),
+    NOW(),
+    0,
+    sqlc.arg(content_hash),
     NOW()
 )
 ON CONFLICT (provider_id, provider_content_id)
@@ -21,35 +27,165 @@ DO UPDATE SET
     title = EXCLUDED.title,
     content_type = EXCLUDED.content_type,
     published_at = EXCLUDED.published_at,
-    is_active = EXCLUDED.is_active,
+    last_seen_at = NOW(),
+    missed_sync_count = 0,
+    content_hash = EXCLUDED.content_hash,
     updated_at = NOW()
 RETURNING id;
 -- name: SearchContents :many
 SELECT 
-    id,
-    provider_id,
-    provider_content_id,
-    title,
-    content_type,
-    published_at,
-    is_active,
-    created_at,
-    updated_at
-FROM contents
+    c.id,
+    c.provider_id,
+    c.provider_content_id,
+    c.title,
+    c.content_type,
+    c.published_at,
+    c.is_active,
+    c.created_at,
+    c.updated_at,
+    c.last_seen_at,
+    c.missed_sync_count,
+    r.relevance
+FROM contents c
+LEFT JOIN content_scores cs ON cs.content_id = c.id
+LEFT JOIN content_search_documents sd ON sd.content_id = c.id
+CROSS JOIN LATERAL (
+    SELECT GREATEST(
+        COALESCE(ts_rank(sd.search_vector, to_tsquery(sqlc.arg(language)::text::regconfig, sqlc.narg(ts_query)::text)), 0),
+        CASE WHEN sqlc.arg(fuzzy)::bool THEN COALESCE(word_similarity(sqlc.narg(query)::text, c.title), 0) ELSE 0 END
+    )::float8 AS relevance
+) r
 WHERE
-    is_active = true
-    AND (sqlc.narg(query)::text IS NULL OR title ILIKE '%' || sqlc.narg(query)::text || '%')
-    AND (sqlc.narg(content_type)::varchar IS NULL OR content_type = sqlc.narg(content_type)::varchar)
-ORDER BY id DESC
+    c.is_active = true
+    AND (sqlc.narg(ts_query)::text IS NULL OR sd.search_vector @@ to_tsquery(sqlc.arg(language)::text::regconfig, sqlc.narg(ts_query)::text))
+    AND (
+        sqlc.narg(query)::text IS NULL
+        OR c.title ILIKE '%' || sqlc.narg(query)::text || '%'
+        OR (sqlc.arg(fuzzy)::bool AND sqlc.narg(query)::text <% c.title)
+    )
+    AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+    AND (
+        cardinality(sqlc.arg(include_tags)::text[]) = 0
+        OR (
+            SELECT COUNT(DISTINCT t.name)
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(include_tags)::text[])
+        ) >= CASE WHEN sqlc.arg(match_all_tags)::bool THEN cardinality(sqlc.arg(include_tags)::text[]) ELSE 1 END
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM content_tags ct
+        INNER JOIN tags t ON t.id = ct.tag_id
+        WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(exclude_tags)::text[])
+    )
+    AND (sqlc.narg(published_after)::timestamp IS NULL OR c.published_at >= sqlc.narg(published_after)::timestamp)
+    AND (sqlc.narg(published_before)::timestamp IS NULL OR c.published_at < sqlc.narg(published_before)::timestamp)
+    AND (cardinality(sqlc.arg(provider_ids)::bigint[]) = 0 OR c.provider_id = ANY(sqlc.arg(provider_ids)::bigint[]))
+ORDER BY
+    CASE WHEN sqlc.arg(sort_order)::text = 'score_desc' THEN COALESCE(cs.final_score, 0) + sqlc.arg(relevance_weight)::float8 * r.relevance END DESC,
+    CASE WHEN sqlc.arg(sort_order)::text = 'score_asc' THEN COALESCE(cs.final_score, 0) + sqlc.arg(relevance_weight)::float8 * r.relevance END ASC,
+    CASE WHEN sqlc.arg(sort_order)::text = 'date_desc' THEN c.published_at END DESC,
+    CASE WHEN sqlc.arg(sort_order)::text = 'date_asc' THEN c.published_at END ASC,
+    c.id DESC
 LIMIT sqlc.arg(limit_count) OFFSET sqlc.arg(offset_count);
 
 -- name: CountContents :one
 SELECT COUNT(*)
-FROM contents
+FROM contents c
+LEFT JOIN content_search_documents sd ON sd.content_id = c.id
 WHERE
-    is_active = true
-    AND (sqlc.narg(query)::text IS NULL OR title ILIKE '%' || sqlc.narg(query)::text || '%')
-    AND (sqlc.narg(content_type)::varchar IS NULL OR content_type = sqlc.narg(content_type)::varchar);
+    c.is_active = true
+    AND (sqlc.narg(ts_query)::text IS NULL OR sd.search_vector @@ to_tsquery(sqlc.arg(language)::text::regconfig, sqlc.narg(ts_query)::text))
+    AND (
+        sqlc.narg(query)::text IS NULL
+        OR c.title ILIKE '%' || sqlc.narg(query)::text || '%'
+        OR (sqlc.arg(fuzzy)::bool AND sqlc.narg(query)::text <% c.title)
+    )
+    AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+    AND (
+        cardinality(sqlc.arg(include_tags)::text[]) = 0
+        OR (
+            SELECT COUNT(DISTINCT t.name)
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(include_tags)::text[])
+        ) >= CASE WHEN sqlc.arg(match_all_tags)::bool THEN cardinality(sqlc.arg(include_tags)::text[]) ELSE 1 END
+    )
+    AND NOT EXISTS (
+        SELECT 1
+        FROM content_tags ct
+        INNER JOIN tags t ON t.id = ct.tag_id
+        WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(exclude_tags)::text[])
+    )
+    AND (sqlc.narg(published_after)::timestamp IS NULL OR c.published_at >= sqlc.narg(published_after)::timestamp)
+    AND (sqlc.narg(published_before)::timestamp IS NULL OR c.published_at < sqlc.narg(published_before)::timestamp)
+    AND (cardinality(sqlc.arg(provider_ids)::bigint[]) = 0 OR c.provider_id = ANY(sqlc.arg(provider_ids)::bigint[]));
+
+
+-- name: SearchFacets :many
+WITH matched AS (
+    SELECT c.id, c.content_type, c.provider_id, c.published_at
+    FROM contents c
+    LEFT JOIN content_search_documents sd ON sd.content_id = c.id
+    WHERE
+        c.is_active = true
+        AND (sqlc.narg(ts_query)::text IS NULL OR sd.search_vector @@ to_tsquery(sqlc.arg(language)::text::regconfig, sqlc.narg(ts_query)::text))
+        AND (
+            sqlc.narg(query)::text IS NULL
+            OR c.title ILIKE '%' || sqlc.narg(query)::text || '%'
+            OR (sqlc.arg(fuzzy)::bool AND sqlc.narg(query)::text <% c.title)
+        )
+        AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+        AND (
+            cardinality(sqlc.arg(include_tags)::text[]) = 0
+            OR (
+                SELECT COUNT(DISTINCT t.name)
+                FROM content_tags ct
+                INNER JOIN tags t ON t.id = ct.tag_id
+                WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(include_tags)::text[])
+            ) >= CASE WHEN sqlc.arg(match_all_tags)::bool THEN cardinality(sqlc.arg(include_tags)::text[]) ELSE 1 END
+        )
+        AND NOT EXISTS (
+            SELECT 1
+            FROM content_tags ct
+            INNER JOIN tags t ON t.id = ct.tag_id
+            WHERE ct.content_id = c.id AND t.name = ANY(sqlc.arg(exclude_tags)::text[])
+        )
+        AND (sqlc.narg(published_after)::timestamp IS NULL OR c.published_at >= sqlc.narg(published_after)::timestamp)
+        AND (sqlc.narg(published_before)::timestamp IS NULL OR c.published_at < sqlc.narg(published_before)::timestamp)
+        AND (cardinality(sqlc.arg(provider_ids)::bigint[]) = 0 OR c.provider_id = ANY(sqlc.arg(provider_ids)::bigint[]))
+),
+tag_counts AS (
+    SELECT t.name, COUNT(*)::bigint AS count
+    FROM matched m
+    INNER JOIN content_tags ct ON ct.content_id = m.id
+    INNER JOIN tags t ON t.id = ct.tag_id
+    GROUP BY t.name
+    ORDER BY count DESC, t.name
+    LIMIT sqlc.arg(tag_limit)
+)
+SELECT 'content_type'::text AS facet, m.content_type::text AS value, m.content_type::text AS label, COUNT(*)::bigint AS count
+FROM matched m
+GROUP BY m.content_type
+UNION ALL
+SELECT 'provider'::text, p.code::text, p.name::text, COUNT(*)::bigint
+FROM matched m
+INNER JOIN providers p ON p.id = m.provider_id
+GROUP BY p.code, p.name
+UNION ALL
+SELECT 'tag'::text, tc.name::text, tc.name::text, tc.count
+FROM tag_counts tc
+UNION ALL
+SELECT 'published_at'::text, b.bucket, b.bucket, COUNT(m.id)::bigint
+FROM (VALUES
+    ('past_day', INTERVAL '1 day'),
+    ('past_week', INTERVAL '7 days'),
+    ('past_month', INTERVAL '30 days'),
+    ('past_year', INTERVAL '365 days')
+) AS b(bucket, span)
+LEFT JOIN matched m ON m.published_at >= NOW() - b.span
+GROUP BY b.bucket;
 
 
 -- name: GetContentByID :one
@@ -62,7 +198,10 @@ SELECT
     published_at,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    last_seen_at,
+    missed_sync_count,
+    content_hash
 FROM contents
 WHERE id = sqlc.arg(content_id);
 
@@ -76,6 +215,94 @@ SELECT
     published_at,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    last_seen_at,
+    missed_sync_count,
+    content_hash
 FROM contents
 WHERE id = ANY(sqlc.arg(content_ids)::bigint[]);
+
+-- name: GetContentHashes :many
+SELECT provider_content_id, content_hash
+FROM contents
+WHERE
+    provider_id = sqlc.arg(provider_id)
+    AND provider_content_id = ANY(sqlc.arg(provider_content_ids)::text[]);
+
+-- name: MarkContentsSeen :exec
+UPDATE contents
+SET
+    last_seen_at = NOW(),
+    missed_sync_count = 0
+WHERE
+    provider_id = sqlc.arg(provider_id)
+    AND provider_content_id = ANY(sqlc.arg(provider_content_ids)::text[]);
+
+-- name: CountActiveContentsByProvider :one
+SELECT COUNT(*)
+FROM contents
+WHERE provider_id = sqlc.arg(provider_id) AND is_active = true;
+
+-- name: ReactivateSeenContents :execrows
+UPDATE contents
+SET
+    is_active = true,
+    updated_at = NOW()
+WHERE
+    provider_id = sqlc.arg(provider_id)
+    AND is_active = false
+    AND provider_content_id = ANY(sqlc.arg(provider_content_ids)::text[]);
+
+-- name: IncrementMissedSyncCount :exec
+UPDATE contents
+SET missed_sync_count = missed_sync_count + 1
+WHERE
+    provider_id = sqlc.arg(provider_id)
+    AND is_active = true
+    AND NOT (provider_content_id = ANY(sqlc.arg(provider_content_ids)::text[]));
+
+-- name: DeactivateMissingContents :execrows
+UPDATE contents
+SET
+    is_active = false,
+    updated_at = NOW()
+WHERE
+    provider_id = sqlc.arg(provider_id)
+    AND is_active = true
+    AND missed_sync_count >= sqlc.arg(min_missed_syncs)::int
+    AND (last_seen_at IS NULL OR last_seen_at < sqlc.arg(seen_before)::timestamp);
+
+-- name: UpsertContentSearchDocuments :exec
+INSERT INTO content_search_documents (
+    content_id,
+    search_vector,
+    updated_at
+)
+SELECT
+    c.id,
+    setweight(to_tsvector(sqlc.arg(language)::text::regconfig, c.title), 'A') ||
+        setweight(to_tsvector(sqlc.arg(language)::text::regconfig, COALESCE(string_agg(t.name, ' '), '')), 'B'),
+    NOW()
+FROM contents c
+LEFT JOIN content_tags ct ON ct.content_id = c.id
+LEFT JOIN tags t ON t.id = ct.tag_id
+WHERE c.id = ANY(sqlc.arg(content_ids)::bigint[])
+GROUP BY c.id, c.title
+ON CONFLICT (content_id)
+DO UPDATE SET
+    search_vector = EXCLUDED.search_vector,
+    updated_at = NOW();
+-- name: AutocompleteTitles :many
+SELECT c.title
+FROM contents c
+LEFT JOIN content_scores cs ON cs.content_id = c.id
+WHERE
+    c.is_active = true
+    AND (c.title ILIKE sqlc.arg(prefix)::text || '%' OR c.title ILIKE '% ' || sqlc.arg(prefix)::text || '%')
+    AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+GROUP BY c.title
+ORDER BY
+    (c.title ILIKE sqlc.arg(prefix)::text || '%') DESC,
+    MAX(COALESCE(cs.final_score, 0)) DESC,
+    c.title
+LIMIT sqlc.arg(limit_count);
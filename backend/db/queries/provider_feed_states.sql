@@ -0,0 +1,25 @@
+-- name: GetProviderFeedState :one
+SELECT provider_id, feed_url, etag, last_modified, updated_at
+FROM provider_feed_states
+WHERE provider_id = sqlc.arg(provider_id);
+
+-- name: UpsertProviderFeedState :exec
+INSERT INTO provider_feed_states (
+    provider_id,
+    feed_url,
+    etag,
+    last_modified,
+    updated_at
+) VALUES (
+    sqlc.arg(provider_id),
+    sqlc.arg(feed_url),
+    sqlc.arg(etag),
+    sqlc.arg(last_modified),
+    NOW()
+)
+ON CONFLICT (provider_id)
+DO UPDATE SET
+    feed_url = EXCLUDED.feed_url,
+    etag = EXCLUDED.etag,
+    last_modified = EXCLUDED.last_modified,
+    updated_at = NOW();
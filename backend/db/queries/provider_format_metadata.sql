@@ -0,0 +1,5 @@
+-- name: GetEnabledProviderFormats :many
+SELECT id
+FROM provider_format_metadata
+WHERE is_enabled = true
+ORDER BY sort_order;
@@ -1,31 +1,56 @@
 -- name: GetAllEnabledProviders :many
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE is_enabled = true;
 
 -- name: GetProviderByCode :one
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE code = sqlc.arg(code);
 
 -- name: GetProviderByID :one
-SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
 FROM providers
 WHERE id = sqlc.arg(provider_id);
 
+-- name: ListProviders :many
+SELECT id, name, code, format, base_url, is_enabled, created_at, updated_at,
+    sync_interval_seconds, sync_cron, sync_jitter_seconds, feed_pagination,
+    feed_mapping, feed_auth
+FROM providers
+ORDER BY name, code;
+
 -- name: UpsertProvider :exec
 INSERT INTO providers (
     name,
     code,
     format,
     base_url,
-    is_enabled
+    is_enabled,
+    sync_interval_seconds,
+    sync_cron,
+    sync_jitter_seconds,
+    feed_pagination,
+    feed_mapping,
+    feed_auth
 ) VALUES (
     sqlc.arg(name),
     sqlc.arg(code),
     sqlc.arg(format),
     sqlc.arg(base_url),
-    sqlc.arg(is_enabled)
+    sqlc.arg(is_enabled),
+    sqlc.arg(sync_interval_seconds),
+    sqlc.arg(sync_cron),
+    sqlc.arg(sync_jitter_seconds),
+    sqlc.arg(feed_pagination),
+    sqlc.arg(feed_mapping),
+    sqlc.arg(feed_auth)
 )
 ON CONFLICT (code)
 DO UPDATE SET
@@ -33,5 +58,20 @@ DO UPDATE SET
     format = EXCLUDED.format,
     base_url = EXCLUDED.base_url,
     is_enabled = EXCLUDED.is_enabled,
+    sync_interval_seconds = EXCLUDED.sync_interval_seconds,
+    sync_cron = EXCLUDED.sync_cron,
+    sync_jitter_seconds = EXCLUDED.sync_jitter_seconds,
+    feed_pagination = EXCLUDED.feed_pagination,
+    feed_mapping = EXCLUDED.feed_mapping,
+    feed_auth = EXCLUDED.feed_auth,
     updated_at = NOW();
 
+-- name: ProviderHasContents :one
+SELECT EXISTS (
+    SELECT 1 FROM contents WHERE provider_id = sqlc.arg(provider_id)
+) AS has_contents;
+
+-- name: DeleteProvider :exec
+DELETE FROM providers
+WHERE id = sqlc.arg(provider_id);
+
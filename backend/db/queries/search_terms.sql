@@ -0,0 +1,25 @@
+-- name: UpsertSearchTerms :exec
+INSERT INTO search_terms (term, updated_at)
+SELECT DISTINCT words.term, NOW()
+FROM (
+    SELECT regexp_split_to_table(lower(c.title), '[^[:alnum:]]+') AS term
+    FROM contents c
+    WHERE c.id = ANY(sqlc.arg(content_ids)::bigint[])
+    UNION
+    SELECT lower(t.name) AS term
+    FROM tags t
+    INNER JOIN content_tags ct ON ct.tag_id = t.id
+    WHERE ct.content_id = ANY(sqlc.arg(content_ids)::bigint[])
+) words
+WHERE length(words.term) >= 3
+ON CONFLICT (term)
+DO UPDATE SET updated_at = NOW();
+
+-- name: SuggestSearchTerms :many
+SELECT
+    term,
+    similarity(term, sqlc.arg(word)::text)::float8 AS similarity
+FROM search_terms
+WHERE term % sqlc.arg(word)::text
+ORDER BY similarity DESC, term
+LIMIT sqlc.arg(limit_count);
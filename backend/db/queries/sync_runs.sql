@@ -0,0 +1,48 @@
+-- name: CreateSyncRun :one
+INSERT INTO provider_sync_runs (
+    provider_id,
+    started_at,
+    status
+) VALUES (
+    sqlc.arg(provider_id),
+    sqlc.arg(started_at),
+    sqlc.arg(status)
+)
+RETURNING id;
+
+-- name: UpdateSyncRun :exec
+UPDATE provider_sync_runs
+SET
+    finished_at = sqlc.narg(finished_at),
+    status = sqlc.arg(status),
+    item_count = sqlc.arg(item_count),
+    upserted_count = sqlc.arg(upserted_count),
+    stats_count = sqlc.arg(stats_count),
+    tags_count = sqlc.arg(tags_count),
+    skipped_count = sqlc.arg(skipped_count),
+    deactivated_count = sqlc.arg(deactivated_count),
+    reactivated_count = sqlc.arg(reactivated_count),
+    unchanged_count = sqlc.arg(unchanged_count),
+    not_modified = sqlc.arg(not_modified),
+    error_message = sqlc.narg(error_message)
+WHERE id = sqlc.arg(id);
+
+-- name: ListSyncRunsByProvider :many
+SELECT id, provider_id, started_at, finished_at, status, item_count, error_message, created_at,
+    upserted_count, stats_count, tags_count, skipped_count, deactivated_count, reactivated_count,
+    unchanged_count, not_modified
+FROM provider_sync_runs
+WHERE provider_id = sqlc.arg(provider_id)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: GetSyncRunByID :one
+SELECT id, provider_id, started_at, finished_at, status, item_count, error_message, created_at,
+    upserted_count, stats_count, tags_count, skipped_count, deactivated_count, reactivated_count,
+    unchanged_count, not_modified
+FROM provider_sync_runs
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteSyncRunsByProvider :exec
+DELETE FROM provider_sync_runs
+WHERE provider_id = sqlc.arg(provider_id);
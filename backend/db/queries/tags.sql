@@ -16,6 +16,37 @@ FROM tags t
 INNER JOIN content_tags ct ON ct.tag_id = t.id
 WHERE ct.content_id = sqlc.arg(content_id);
 
+-- name: GetTagsByContentIDs :many
+SELECT ct.content_id, t.id, t.name
+FROM content_tags ct
+INNER JOIN tags t ON t.id = ct.tag_id
+WHERE ct.content_id = ANY(sqlc.arg(content_ids)::bigint[])
+ORDER BY ct.content_id, t.name;
+
+-- name: ListTagsWithCounts :many
+SELECT t.id, t.name, COUNT(*)::bigint AS content_count
+FROM tags t
+INNER JOIN content_tags ct ON ct.tag_id = t.id
+INNER JOIN contents c ON c.id = ct.content_id
+WHERE
+    c.is_active = true
+    AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+GROUP BY t.id, t.name
+ORDER BY content_count DESC, t.name
+LIMIT sqlc.arg(limit_count);
+
 -- name: RemoveContentTags :exec
 DELETE FROM content_tags
 WHERE content_id = sqlc.arg(content_id);
+-- name: AutocompleteTags :many
+SELECT t.name
+FROM tags t
+INNER JOIN content_tags ct ON ct.tag_id = t.id
+INNER JOIN contents c ON c.id = ct.content_id
+WHERE
+    t.name LIKE sqlc.arg(prefix)::text || '%'
+    AND c.is_active = true
+    AND (sqlc.narg(content_type)::varchar IS NULL OR c.content_type = sqlc.narg(content_type)::varchar)
+GROUP BY t.name
+ORDER BY COUNT(*) DESC, t.name
+LIMIT sqlc.arg(limit_count);
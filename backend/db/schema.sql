@@ -19,6 +19,13 @@ CREATE TABLE IF NOT EXISTS providers (
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS sync_interval_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS sync_cron TEXT NOT NULL DEFAULT '';
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS sync_jitter_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS feed_pagination JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS feed_mapping JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS feed_auth JSONB NOT NULL DEFAULT '{}';
+
 CREATE TABLE IF NOT EXISTS content_type_metadata (
     id VARCHAR(50) PRIMARY KEY,
     display_name VARCHAR(100) NOT NULL,
@@ -40,6 +47,10 @@ CREATE TABLE IF NOT EXISTS contents (
     UNIQUE(provider_id, provider_content_id)
 );
 
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP;
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS missed_sync_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS content_hash TEXT NOT NULL DEFAULT '';
+
 CREATE TABLE IF NOT EXISTS content_stats (
     id BIGSERIAL PRIMARY KEY,
     content_id BIGINT NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
@@ -71,6 +82,29 @@ CREATE TABLE IF NOT EXISTS content_raw_payloads (
     fetched_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
+CREATE TABLE IF NOT EXISTS content_scores (
+    content_id BIGINT PRIMARY KEY REFERENCES contents(id) ON DELETE CASCADE,
+    base_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    type_multiplier DOUBLE PRECISION NOT NULL DEFAULT 1,
+    recency_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    engagement_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    final_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    computed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS content_search_documents (
+    content_id BIGINT PRIMARY KEY REFERENCES contents(id) ON DELETE CASCADE,
+    search_vector TSVECTOR NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Known words from titles and tag names, used to suggest corrections for
+-- misspelled queries.
+CREATE TABLE IF NOT EXISTS search_terms (
+    term TEXT PRIMARY KEY,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
 CREATE TABLE IF NOT EXISTS provider_sync_runs (
     id BIGSERIAL PRIMARY KEY,
     provider_id BIGINT NOT NULL REFERENCES providers(id),
@@ -82,10 +116,31 @@ CREATE TABLE IF NOT EXISTS provider_sync_runs (
     created_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS upserted_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS stats_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS tags_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS skipped_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS deactivated_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS reactivated_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS unchanged_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE provider_sync_runs ADD COLUMN IF NOT EXISTS not_modified BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS provider_feed_states (
+    provider_id BIGINT PRIMARY KEY REFERENCES providers(id) ON DELETE CASCADE,
+    feed_url TEXT NOT NULL,
+    etag TEXT NOT NULL DEFAULT '',
+    last_modified TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
 CREATE INDEX IF NOT EXISTS idx_contents_type ON contents (content_type);
+CREATE INDEX IF NOT EXISTS idx_contents_provider_active ON contents (provider_id, is_active);
 CREATE INDEX IF NOT EXISTS idx_contents_published ON contents (published_at DESC);
 CREATE INDEX IF NOT EXISTS idx_contents_title_trgm ON contents USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_content_search_documents_vector ON content_search_documents USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_search_terms_trgm ON search_terms USING gin (term gin_trgm_ops);
 CREATE INDEX IF NOT EXISTS idx_content_stats_views ON content_stats (views DESC);
+CREATE INDEX IF NOT EXISTS idx_content_scores_final ON content_scores (final_score DESC);
 CREATE INDEX IF NOT EXISTS idx_tags_name ON tags (name);
 CREATE INDEX IF NOT EXISTS idx_sync_runs_provider ON provider_sync_runs (provider_id, created_at DESC);
 
@@ -100,7 +155,9 @@ CREATE TABLE IF NOT EXISTS scoring_rules (
 
 INSERT INTO provider_format_metadata (id, display_name, is_enabled, sort_order) VALUES
 ('json', 'JSON Format', true, 1),
-('xml', 'XML Format', true, 2)
+('xml', 'XML Format', true, 2),
+('csv', 'CSV Format', true, 3),
+('rss', 'RSS/Atom Feed', true, 4)
 ON CONFLICT (id) DO NOTHING;
 
 INSERT INTO providers (name, code, format, base_url, is_enabled) VALUES
@@ -131,7 +188,12 @@ INSERT INTO scoring_rules (key, value, description) VALUES
     "week_score": 5.0,
     "month_score": 3.0,
     "quarter_score": 1.0
-}', 'Configuration for Recency scoring')
+}', 'Configuration for Recency scoring'),
+
+-- Relevance Configuration
+('relevance_config', '{
+    "weight": 10.0
+}', 'Weight of full-text relevance in the final score')
 ON CONFLICT (key) DO NOTHING;
 
 -- Seed Content Type Metadata
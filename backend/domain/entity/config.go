@@ -3,14 +3,19 @@ package entity
 import "time"
 
 type AppConfig struct {
-	Server     ServerConfig     `mapstructure:"server"`
-	Database   DatabaseConfig   `mapstructure:"database"`
-	Redis      RedisConfig      `mapstructure:"redis"`
-	Sync       SyncConfig       `mapstructure:"sync"`
-	Cache      CacheConfig      `mapstructure:"cache"`
-	Pagination PaginationConfig `mapstructure:"pagination"`
-	RateLimit  RateLimitConfig  `mapstructure:"rate_limit"`
+	Server         ServerConfig         `mapstructure:"server"`
+	Database       DatabaseConfig       `mapstructure:"database"`
+	Redis          RedisConfig          `mapstructure:"redis"`
+	Sync           SyncConfig           `mapstructure:"sync"`
+	Cache          CacheConfig          `mapstructure:"cache"`
+	Pagination     PaginationConfig     `mapstructure:"pagination"`
+	RateLimit      RateLimitConfig      `mapstructure:"rate_limit"`
 	CircuitBreaker CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	Retry          RetryConfig          `mapstructure:"retry"`
+	ScoreRefresh   ScoreRefreshConfig   `mapstructure:"score_refresh"`
+	Search         SearchConfig         `mapstructure:"search"`
+	Admin          AdminConfig          `mapstructure:"admin"`
+	FeedSecrets    FeedSecretsConfig    `mapstructure:"feed_secrets"`
 }
 
 type RateLimitConfig struct {
@@ -18,19 +23,152 @@ type RateLimitConfig struct {
 	Burst int `mapstructure:"burst"`
 }
 
+// CircuitBreakerConfig configures the circuit breaker kept for each
+// provider. Breakers are named Name followed by the provider code.
 type CircuitBreakerConfig struct {
-	Name          string `mapstructure:"name"`
-	MaxRequests   uint32 `mapstructure:"max_requests"`
-	Interval      int    `mapstructure:"interval"`
-	Timeout       int    `mapstructure:"timeout"`
-	ReadyToTrip   bool   `mapstructure:"ready_to_trip"` // Simplified for config, logic in code
+	Name                     string `mapstructure:"name"`
+	CircuitBreakerThresholds `mapstructure:",squash"`
+	// Providers overrides the thresholds by provider code; zero fields keep
+	// the defaults.
+	Providers map[string]CircuitBreakerThresholds `mapstructure:"providers"`
 }
+
+// CircuitBreakerThresholds trip a breaker once it saw MinRequests fetches
+// within Interval seconds and FailureRatio of them failed. It then stays
+// open for Timeout seconds before letting MaxRequests trial fetches through.
+type CircuitBreakerThresholds struct {
+	MaxRequests  uint32  `mapstructure:"max_requests"`
+	Interval     int     `mapstructure:"interval"`
+	Timeout      int     `mapstructure:"timeout"`
+	MinRequests  uint32  `mapstructure:"min_requests"`
+	FailureRatio float64 `mapstructure:"failure_ratio"`
+}
+
+// ForProvider returns the thresholds of the provider with code.
+func (c CircuitBreakerConfig) ForProvider(code string) CircuitBreakerThresholds {
+	thresholds := c.CircuitBreakerThresholds
+	override := c.Providers[code]
+	if override.MaxRequests > 0 {
+		thresholds.MaxRequests = override.MaxRequests
+	}
+	if override.Interval > 0 {
+		thresholds.Interval = override.Interval
+	}
+	if override.Timeout > 0 {
+		thresholds.Timeout = override.Timeout
+	}
+	if override.MinRequests > 0 {
+		thresholds.MinRequests = override.MinRequests
+	}
+	if override.FailureRatio > 0 {
+		thresholds.FailureRatio = override.FailureRatio
+	}
+	return thresholds
+}
+
+func (t CircuitBreakerThresholds) GetMinRequests() uint32 {
+	if t.MinRequests == 0 {
+		return 3
+	}
+	return t.MinRequests
+}
+
+func (t CircuitBreakerThresholds) GetFailureRatio() float64 {
+	if t.FailureRatio <= 0 {
+		return 0.6
+	}
+	if t.FailureRatio > 1 {
+		return 1
+	}
+	return t.FailureRatio
+}
+
+// RetryConfig configures the retries of a provider fetch that failed before
+// handing over a page. Retries wait an exponential backoff with jitter, or
+// longer when the provider sent Retry-After, and draw from a per provider
+// budget: every fetch adds BudgetRatio of a retry to it, up to BudgetBurst.
+type RetryConfig struct {
+	MaxAttempts       int     `mapstructure:"max_attempts"`
+	InitialBackoffMs  int     `mapstructure:"initial_backoff_ms"`
+	MaxBackoffSeconds int     `mapstructure:"max_backoff_seconds"`
+	BudgetRatio       float64 `mapstructure:"budget_ratio"`
+	BudgetBurst       int     `mapstructure:"budget_burst"`
+}
+
+// GetMaxAttempts counts the first attempt; 1 disables retries.
+func (c RetryConfig) GetMaxAttempts() int {
+	if c.MaxAttempts <= 0 {
+		return 3
+	}
+	return c.MaxAttempts
+}
+
+func (c RetryConfig) GetInitialBackoff() time.Duration {
+	if c.InitialBackoffMs <= 0 {
+		return time.Second
+	}
+	return time.Duration(c.InitialBackoffMs) * time.Millisecond
+}
+
+// GetMaxBackoff caps the backoff. A provider asking for a longer wait
+// through Retry-After is not retried.
+func (c RetryConfig) GetMaxBackoff() time.Duration {
+	if c.MaxBackoffSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(c.MaxBackoffSeconds) * time.Second
+}
+
+func (c RetryConfig) GetBudgetRatio() float64 {
+	if c.BudgetRatio <= 0 {
+		return 0.2
+	}
+	return c.BudgetRatio
+}
+
+func (c RetryConfig) GetBudgetBurst() float64 {
+	if c.BudgetBurst <= 0 {
+		return 5
+	}
+	return float64(c.BudgetBurst)
+}
+
 type PaginationConfig struct {
 	DefaultPage     int `mapstructure:"default_page"`
 	DefaultPageSize int `mapstructure:"default_page_size"`
 	MaxPageSize     int `mapstructure:"max_page_size"`
 }
 
+// AdminConfig protects the provider administration API; it is disabled
+// while APIKey is empty. Set it through ADMIN_API_KEY.
+type AdminConfig struct {
+	APIKey string `mapstructure:"api_key"`
+}
+
+// FeedSecretsConfig bounds the secrets a provider's feed auth may reference,
+// so the admin API cannot send other secrets of the server to a feed.
+type FeedSecretsConfig struct {
+	// EnvPrefix starts the name of every environment variable a feed may
+	// read.
+	EnvPrefix string `mapstructure:"env_prefix"`
+	// Dir holds every file a feed may read.
+	Dir string `mapstructure:"dir"`
+}
+
+func (c FeedSecretsConfig) GetEnvPrefix() string {
+	if c.EnvPrefix == "" {
+		return "FEED_SECRET_"
+	}
+	return c.EnvPrefix
+}
+
+func (c FeedSecretsConfig) GetDir() string {
+	if c.Dir == "" {
+		return "/run/secrets/feeds"
+	}
+	return c.Dir
+}
+
 type ServerConfig struct {
 	GRPCPort int `mapstructure:"grpc_port"`
 	HTTPPort int `mapstructure:"http_port"`
@@ -45,7 +183,17 @@ type RedisConfig struct {
 }
 
 type SyncConfig struct {
-	IntervalSeconds int `mapstructure:"interval_seconds"`
+	IntervalSeconds        int     `mapstructure:"interval_seconds"`
+	PollSeconds            int     `mapstructure:"poll_seconds"`
+	Concurrency            int     `mapstructure:"concurrency"`
+	ProviderTimeoutSeconds int     `mapstructure:"provider_timeout_seconds"`
+	LeaderCheckSeconds     int     `mapstructure:"leader_check_seconds"`
+	DeactivateAfterMisses  int     `mapstructure:"deactivate_after_misses"`
+	DeactivateGraceSeconds int     `mapstructure:"deactivate_grace_seconds"`
+	MinFetchRatio          float64 `mapstructure:"min_fetch_ratio"`
+	MaxFeedBodyBytes       int64   `mapstructure:"max_feed_body_bytes"`
+	MaxFeedItems           int     `mapstructure:"max_feed_items"`
+	BatchSize              int     `mapstructure:"batch_size"`
 }
 
 func (c SyncConfig) GetInterval() time.Duration {
@@ -55,8 +203,102 @@ func (c SyncConfig) GetInterval() time.Duration {
 	return time.Duration(c.IntervalSeconds) * time.Second
 }
 
+// GetPollInterval is the longest the scheduler sleeps before re-reading
+// providers and their schedules.
+func (c SyncConfig) GetPollInterval() time.Duration {
+	if c.PollSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(c.PollSeconds) * time.Second
+}
+
+// GetConcurrency is the number of providers synced in parallel.
+func (c SyncConfig) GetConcurrency() int {
+	if c.Concurrency <= 0 {
+		return 4
+	}
+	return c.Concurrency
+}
+
+// GetProviderTimeout bounds a single provider's sync, including its fetch
+// and writes.
+func (c SyncConfig) GetProviderTimeout() time.Duration {
+	if c.ProviderTimeoutSeconds <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(c.ProviderTimeoutSeconds) * time.Second
+}
+
+// GetLeaderCheckInterval is how often replicas campaign for the sync
+// leadership and the leader checks it still holds it.
+func (c SyncConfig) GetLeaderCheckInterval() time.Duration {
+	if c.LeaderCheckSeconds <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(c.LeaderCheckSeconds) * time.Second
+}
+
+// GetDeactivateAfterMisses is the number of consecutive successful syncs an
+// item must be missing from before it is marked inactive.
+func (c SyncConfig) GetDeactivateAfterMisses() int32 {
+	if c.DeactivateAfterMisses <= 0 {
+		return 3
+	}
+	return int32(c.DeactivateAfterMisses)
+}
+
+func (c SyncConfig) GetDeactivateGrace() time.Duration {
+	if c.DeactivateGraceSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(c.DeactivateGraceSeconds) * time.Second
+}
+
+// GetMinFetchRatio is the minimum share of a provider's active catalogue a
+// fetch must return before missing items are counted against.
+func (c SyncConfig) GetMinFetchRatio() float64 {
+	if c.MinFetchRatio <= 0 {
+		return 0.5
+	}
+	if c.MinFetchRatio > 1 {
+		return 1
+	}
+	return c.MinFetchRatio
+}
+
+// GetMaxFeedBodyBytes bounds the size of a single feed response; a larger
+// response fails the fetch.
+func (c SyncConfig) GetMaxFeedBodyBytes() int64 {
+	if c.MaxFeedBodyBytes <= 0 {
+		return 512 << 20
+	}
+	return c.MaxFeedBodyBytes
+}
+
+// GetMaxFeedItems is the most items read from a provider's feed in one
+// sync, over all its pages. Reading stops there and the fetch is treated
+// as truncated.
+func (c SyncConfig) GetMaxFeedItems() int {
+	if c.MaxFeedItems <= 0 {
+		return 500000
+	}
+	return c.MaxFeedItems
+}
+
+// GetBatchSize is the number of fetched items written per write round trip.
+func (c SyncConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return 500
+	}
+	return c.BatchSize
+}
+
 type CacheConfig struct {
-	TTLSeconds int `mapstructure:"ttl_seconds"`
+	TTLSeconds        int `mapstructure:"ttl_seconds"`
+	SuggestTTLSeconds int `mapstructure:"suggest_ttl_seconds"`
+	// ProviderTTLSeconds bounds how long the in-process provider cache may
+	// miss changes made by other instances.
+	ProviderTTLSeconds int `mapstructure:"provider_ttl_seconds"`
 }
 
 func (c CacheConfig) GetTTL() time.Duration {
@@ -65,3 +307,77 @@ func (c CacheConfig) GetTTL() time.Duration {
 	}
 	return time.Duration(c.TTLSeconds) * time.Second
 }
+
+// GetSuggestTTL is kept short, autocomplete is called on every keystroke
+// and should follow catalogue changes quickly.
+func (c CacheConfig) GetSuggestTTL() time.Duration {
+	if c.SuggestTTLSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(c.SuggestTTLSeconds) * time.Second
+}
+
+func (c CacheConfig) GetProviderTTL() time.Duration {
+	if c.ProviderTTLSeconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(c.ProviderTTLSeconds) * time.Second
+}
+
+type ScoreRefreshConfig struct {
+	IntervalSeconds  int `mapstructure:"interval_seconds"`
+	RulesPollSeconds int `mapstructure:"rules_poll_seconds"`
+}
+
+func (c ScoreRefreshConfig) GetInterval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return 900 * time.Second
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+func (c ScoreRefreshConfig) GetRulesPollInterval() time.Duration {
+	if c.RulesPollSeconds <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(c.RulesPollSeconds) * time.Second
+}
+
+type SearchConfig struct {
+	// Language is the PostgreSQL text search configuration, e.g. "english".
+	Language string `mapstructure:"language"`
+	// SuggestBelowResults enables "did you mean" suggestions for searches
+	// returning fewer results than this.
+	SuggestBelowResults int `mapstructure:"suggest_below_results"`
+	SuggestionLimit     int `mapstructure:"suggestion_limit"`
+	// FacetTagLimit caps the tag facet to the most frequent tags.
+	FacetTagLimit int `mapstructure:"facet_tag_limit"`
+}
+
+func (c SearchConfig) GetLanguage() string {
+	if c.Language == "" {
+		return "english"
+	}
+	return c.Language
+}
+
+func (c SearchConfig) GetSuggestBelowResults() int64 {
+	if c.SuggestBelowResults <= 0 {
+		return 3
+	}
+	return int64(c.SuggestBelowResults)
+}
+
+func (c SearchConfig) GetSuggestionLimit() int {
+	if c.SuggestionLimit <= 0 {
+		return 3
+	}
+	return c.SuggestionLimit
+}
+
+func (c SearchConfig) GetFacetTagLimit() int32 {
+	if c.FacetTagLimit <= 0 {
+		return 10
+	}
+	return int32(c.FacetTagLimit)
+}
@@ -19,6 +19,11 @@ type Content struct {
 	IsActive          bool
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	LastSeenAt        *time.Time
+	MissedSyncCount   int32
+	// ContentHash fingerprints the provider item the content was last
+	// written from.
+	ContentHash string
 }
 
 func (c Content) IsVideo() bool {
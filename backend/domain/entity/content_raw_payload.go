@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type ContentRawPayload struct {
+	ContentID  int64
+	ProviderID int64
+	RawPayload []byte
+	FetchedAt  time.Time
+}
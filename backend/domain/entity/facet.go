@@ -0,0 +1,25 @@
+package entity
+
+// Facet buckets of published_at, each counting contents published within
+// that span before now.
+const (
+	PublishedPastDay   = "past_day"
+	PublishedPastWeek  = "past_week"
+	PublishedPastMonth = "past_month"
+	PublishedPastYear  = "past_year"
+)
+
+type FacetValue struct {
+	Value string
+	Label string
+	Count int64
+}
+
+// SearchFacets counts the contents matching a search per content type,
+// provider, tag and publish-date bucket.
+type SearchFacets struct {
+	ContentTypes []FacetValue
+	Providers    []FacetValue
+	Tags         []FacetValue
+	PublishedAt  []FacetValue
+}
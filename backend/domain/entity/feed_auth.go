@@ -0,0 +1,52 @@
+package entity
+
+type FeedAuthKind string
+
+const (
+	FeedAuthNone   FeedAuthKind = ""
+	FeedAuthAPIKey FeedAuthKind = "api_key"
+	FeedAuthBearer FeedAuthKind = "bearer"
+	FeedAuthBasic  FeedAuthKind = "basic"
+	// FeedAuthOAuth2 requests tokens with the OAuth2 client credentials
+	// grant and sends them as bearer tokens, fetching a new one once a token
+	// expires or the provider rejects it.
+	FeedAuthOAuth2 FeedAuthKind = "oauth2_client_credentials"
+)
+
+// FeedAuth authenticates a provider's feed requests. It is stored as JSON on
+// the provider row, so Secret is a reference to the secret and never the
+// secret itself: "env:NAME" reads an environment variable and "file:/path"
+// a file, such as a mounted secret. It holds the API key, the bearer token,
+// the basic auth password or the OAuth2 client secret.
+//
+// Headers and Query are added to every feed request; their values are sent
+// as is unless they are secret references too. Both are only sent to the
+// host of the provider's base URL, not to other hosts its Link headers point
+// to.
+type FeedAuth struct {
+	Kind   FeedAuthKind `json:"kind,omitempty"`
+	Secret string       `json:"secret,omitempty"`
+	// HeaderName carries an API key, X-API-Key by default, unless
+	// QueryParam is set to send it in the query instead.
+	HeaderName string `json:"header_name,omitempty"`
+	QueryParam string `json:"query_param,omitempty"`
+	// Username is the basic auth user.
+	Username string   `json:"username,omitempty"`
+	ClientID string   `json:"client_id,omitempty"`
+	TokenURL string   `json:"token_url,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+
+	Headers map[string]string `json:"headers,omitempty"`
+	Query   map[string]string `json:"query,omitempty"`
+}
+
+func (a FeedAuth) IsZero() bool {
+	return a.Kind == FeedAuthNone && len(a.Headers) == 0 && len(a.Query) == 0
+}
+
+func (a FeedAuth) GetHeaderName() string {
+	if a.HeaderName == "" {
+		return "X-API-Key"
+	}
+	return a.HeaderName
+}
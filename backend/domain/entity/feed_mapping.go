@@ -0,0 +1,89 @@
+package entity
+
+// FeedMapping maps a provider feed of its own schema onto content items, so
+// a provider can be added without a client of its own. Items selects the
+// items in the document; field paths are relative to an item. Paths are
+// JSONPath expressions for JSON feeds and XPath expressions for XML feeds.
+// It is stored as JSON on the provider row; a mapping without Items leaves
+// the feed to the format's built-in schema. Duration is read in seconds and
+// Tags takes every value its path selects.
+//
+// CSV feeds have no items path: every row is an item, field paths name
+// header columns and a field without a path reads the column named after it
+// (id, title, type, views, ...). Delimiter is their field separator, a comma
+// by default.
+type FeedMapping struct {
+	Items       string       `json:"items,omitempty"`
+	Delimiter   string       `json:"delimiter,omitempty"`
+	ID          FieldMapping `json:"id,omitzero"`
+	Title       FieldMapping `json:"title,omitzero"`
+	Type        FieldMapping `json:"type,omitzero"`
+	Views       FieldMapping `json:"views,omitzero"`
+	Likes       FieldMapping `json:"likes,omitzero"`
+	Duration    FieldMapping `json:"duration,omitzero"`
+	ReadingTime FieldMapping `json:"reading_time,omitzero"`
+	Reactions   FieldMapping `json:"reactions,omitzero"`
+	Comments    FieldMapping `json:"comments,omitzero"`
+	PublishedAt FieldMapping `json:"published_at,omitzero"`
+	Tags        FieldMapping `json:"tags,omitzero"`
+}
+
+func (m FeedMapping) IsZero() bool {
+	return m.Items == ""
+}
+
+// Fields returns the mapped fields by name, in a fixed order.
+func (m FeedMapping) Fields() []NamedFieldMapping {
+	return []NamedFieldMapping{
+		{"id", m.ID},
+		{"title", m.Title},
+		{"type", m.Type},
+		{"views", m.Views},
+		{"likes", m.Likes},
+		{"duration", m.Duration},
+		{"reading_time", m.ReadingTime},
+		{"reactions", m.Reactions},
+		{"comments", m.Comments},
+		{"published_at", m.PublishedAt},
+		{"tags", m.Tags},
+	}
+}
+
+type NamedFieldMapping struct {
+	Name    string
+	Mapping FieldMapping
+}
+
+// FieldMapping reads one content field. The values selected by Path, or
+// Default when it selects none, go through Transforms in order.
+type FieldMapping struct {
+	Path    string `json:"path,omitempty"`
+	Default string `json:"default,omitempty"`
+	// Format is the layout of a date: "rfc3339" (the default), "unix",
+	// "unix_ms" or a Go time layout.
+	Format     string           `json:"format,omitempty"`
+	Transforms []ValueTransform `json:"transforms,omitempty"`
+}
+
+type TransformKind string
+
+const (
+	TransformTrim  TransformKind = "trim"
+	TransformLower TransformKind = "lower"
+	TransformUpper TransformKind = "upper"
+	// TransformSplit splits each value on Arg, e.g. comma separated tags.
+	TransformSplit TransformKind = "split"
+	// TransformMap replaces values found in Values, e.g. "vid" by "video".
+	TransformMap TransformKind = "map"
+	// TransformScale multiplies a number by Arg, e.g. minutes by 60.
+	TransformScale TransformKind = "scale"
+	// TransformDuration turns "hh:mm:ss", "mm:ss" or Go durations such as
+	// "1m30s" into seconds.
+	TransformDuration TransformKind = "duration"
+)
+
+type ValueTransform struct {
+	Kind   TransformKind     `json:"kind"`
+	Arg    string            `json:"arg,omitempty"`
+	Values map[string]string `json:"values,omitempty"`
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// FeedValidators are the HTTP cache validators of a fetched feed. Sent back
+// on the next fetch, they let the provider answer 304 Not Modified.
+type FeedValidators struct {
+	ETag         string
+	LastModified string
+}
+
+func (v FeedValidators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// FeedState remembers the validators of a provider's last fetched feed.
+// They only apply while the provider's base URL still equals FeedURL.
+type FeedState struct {
+	ProviderID int64
+	FeedURL    string
+	Validators FeedValidators
+	UpdatedAt  time.Time
+}
@@ -5,15 +5,89 @@ import "time"
 const (
 	ProviderFormatJSON = "json"
 	ProviderFormatXML  = "xml"
+	ProviderFormatCSV  = "csv"
+	// ProviderFormatRSS covers RSS 2.0 and Atom feeds.
+	ProviderFormatRSS = "rss"
 )
 
 type Provider struct {
-	ID        int64
-	Name      string
-	Code      string
-	Format    string
-	BaseURL   string
-	IsEnabled bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID         int64
+	Name       string
+	Code       string
+	Format     string
+	BaseURL    string
+	IsEnabled  bool
+	Schedule   SyncSchedule
+	Pagination FeedPagination
+	Mapping    FeedMapping
+	Auth       FeedAuth
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// SyncSchedule controls when a provider is synced. Cron takes precedence over
+// Interval; with neither set the global sync interval applies. Each run is
+// delayed by a random amount up to Jitter.
+type SyncSchedule struct {
+	Interval time.Duration
+	Cron     string
+	Jitter   time.Duration
+}
+
+type PaginationStrategy string
+
+const (
+	PaginationNone   PaginationStrategy = ""
+	PaginationPage   PaginationStrategy = "page"
+	PaginationOffset PaginationStrategy = "offset"
+	PaginationCursor PaginationStrategy = "cursor"
+	PaginationLink   PaginationStrategy = "link"
+)
+
+const (
+	defaultFeedMaxPages    = 100
+	defaultFeedPageRetries = 2
+)
+
+// FeedPagination describes how a provider splits its feed into pages; with
+// no strategy the feed is a single response. It is stored as JSON on the
+// provider row.
+type FeedPagination struct {
+	Strategy PaginationStrategy `json:"strategy,omitempty"`
+	// Param is the query parameter carrying the page number, offset or
+	// cursor. Link pagination follows the rel="next" Link header instead.
+	Param string `json:"param,omitempty"`
+	// SizeParam, when set, carries PageSize on every request. A page
+	// shorter than PageSize ends page and offset pagination.
+	SizeParam string `json:"size_param,omitempty"`
+	PageSize  int    `json:"page_size,omitempty"`
+	// FirstPage is the number of the first page, 1 if unset.
+	FirstPage int `json:"first_page,omitempty"`
+	// CursorField names the top-level body field holding the next cursor;
+	// an empty cursor ends the feed.
+	CursorField string `json:"cursor_field,omitempty"`
+	MaxPages    int    `json:"max_pages,omitempty"`
+	// PageRetries is how many times a failed page request is retried.
+	PageRetries int `json:"page_retries,omitempty"`
+}
+
+func (p FeedPagination) GetFirstPage() int {
+	if p.FirstPage <= 0 {
+		return 1
+	}
+	return p.FirstPage
+}
+
+func (p FeedPagination) GetMaxPages() int {
+	if p.MaxPages <= 0 {
+		return defaultFeedMaxPages
+	}
+	return p.MaxPages
+}
+
+func (p FeedPagination) GetPageRetries() int {
+	if p.PageRetries <= 0 {
+		return defaultFeedPageRetries
+	}
+	return p.PageRetries
 }
@@ -1,11 +1,22 @@
 package entity
 
+import "time"
+
 type ScoreComponents struct {
-	BaseScore        float64
-	TypeMultiplier   float64
-	RecencyScore     float64
-	EngagementScore  float64
-	FinalScore       float64
+	BaseScore       float64
+	TypeMultiplier  float64
+	RecencyScore    float64
+	EngagementScore float64
+	RelevanceScore  float64
+	FinalScore      float64
+}
+
+// ContentScore is the persisted score of a content, used to order search
+// results across the whole catalogue.
+type ContentScore struct {
+	ContentID  int64
+	Components ScoreComponents
+	ComputedAt time.Time
 }
 
 type ScoringConfig struct {
@@ -20,4 +31,5 @@ type ScoringConfig struct {
 	VideoLikesDivisor      float64
 	TextReadingTimeDivisor float64
 	TextReactionsDivisor   float64
+	RelevanceWeight        float64
 }
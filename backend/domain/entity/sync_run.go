@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+type SyncRunStatus string
+
+const (
+	SyncRunStatusRunning SyncRunStatus = "running"
+	SyncRunStatusSuccess SyncRunStatus = "success"
+	SyncRunStatusFailed  SyncRunStatus = "failed"
+)
+
+type SyncRun struct {
+	ID               int64
+	ProviderID       int64
+	StartedAt        time.Time
+	FinishedAt       *time.Time
+	Status           SyncRunStatus
+	ItemCount        int32
+	UpsertedCount    int32
+	StatsCount       int32
+	TagsCount        int32
+	SkippedCount     int32
+	DeactivatedCount int32
+	ReactivatedCount int32
+	// UnchangedCount is the number of fetched items whose hash matched the
+	// stored one and were not rewritten.
+	UnchangedCount int32
+	// NotModified is set when the provider answered 304 and nothing was
+	// written.
+	NotModified  bool
+	ErrorMessage string
+	CreatedAt    time.Time
+}
+
+func (r SyncRun) IsFinished() bool {
+	return r.FinishedAt != nil
+}
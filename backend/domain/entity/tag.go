@@ -4,3 +4,20 @@ type Tag struct {
 	ID   int64
 	Name string
 }
+
+type TagCount struct {
+	Tag          Tag
+	ContentCount int64
+}
+type CompletionKind string
+
+const (
+	CompletionKindTag   CompletionKind = "tag"
+	CompletionKindTitle CompletionKind = "title"
+)
+
+// Completion is an autocomplete suggestion for a partially typed query.
+type Completion struct {
+	Text string
+	Kind CompletionKind
+}
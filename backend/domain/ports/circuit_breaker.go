@@ -0,0 +1,28 @@
+package ports
+
+const (
+	CircuitBreakerClosed   = "closed"
+	CircuitBreakerHalfOpen = "half-open"
+	CircuitBreakerOpen     = "open"
+)
+
+// CircuitBreakerState is a provider's circuit breaker state and the counts
+// of its current interval.
+type CircuitBreakerState struct {
+	State                string
+	Requests             uint32
+	TotalSuccesses       uint32
+	TotalFailures        uint32
+	ConsecutiveSuccesses uint32
+	ConsecutiveFailures  uint32
+}
+
+// CircuitBreakers holds the circuit breaker of each provider, by provider
+// code.
+type CircuitBreakers interface {
+	// State returns the provider's breaker state; a provider not fetched
+	// since startup or its last reset is closed with zero counts.
+	State(providerCode string) CircuitBreakerState
+	// Reset closes the provider's breaker and clears its counts.
+	Reset(providerCode string)
+}
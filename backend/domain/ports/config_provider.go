@@ -5,3 +5,7 @@ import "github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
 type ConfigProvider interface {
 	GetAppConfig() *entity.AppConfig
 }
+
+type ScoringConfigProvider interface {
+	GetScoringConfig() entity.ScoringConfig
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+type ContentRawPayloadRepository interface {
+	SaveOrUpdatePayloads(ctx context.Context, payloads []entity.ContentRawPayload) error
+	GetByContentID(ctx context.Context, contentID int64) (*entity.ContentRawPayload, error)
+}
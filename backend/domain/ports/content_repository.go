@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
 )
@@ -19,14 +20,84 @@ func (p Pagination) Limit() int32 {
 	return p.PageSize
 }
 
+type SortOrder string
+
+const (
+	SortByScoreDesc SortOrder = "score_desc"
+	SortByScoreAsc  SortOrder = "score_asc"
+	SortByDateDesc  SortOrder = "date_desc"
+	SortByDateAsc   SortOrder = "date_asc"
+)
+
 type SearchFilters struct {
-	Query       string
-	ContentType *entity.ContentType
+	// Query is matched as a title substring, and only when FullTextQuery is
+	// empty.
+	Query string
+	// Fuzzy additionally matches titles by trigram similarity to Query, so
+	// misspelled words still find results.
+	Fuzzy bool
+	// FullTextQuery is a to_tsquery expression matched against titles and tags.
+	FullTextQuery string
+	ContentType   *entity.ContentType
+	// IncludeTags keeps contents having any of these normalized tag names,
+	// or all of them when MatchAllTags is set.
+	IncludeTags  []string
+	MatchAllTags bool
+	// ExcludeTags drops contents having any of these normalized tag names.
+	ExcludeTags []string
+	// PublishedAfter and PublishedBefore bound published_at; the lower bound
+	// is inclusive, the upper exclusive.
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+	// ProviderIDs keeps contents from any of these providers.
+	ProviderIDs []int64
+	// Sort is applied in SQL before pagination.
+	Sort SortOrder
+	// RelevanceWeight scales the full-text rank when sorting by score.
+	RelevanceWeight float64
+}
+
+type ContentMatch struct {
+	Content   entity.Content
+	Relevance float64
+}
+
+// ReconcileOptions controls how contents missing from a provider fetch are
+// handled. When DeactivateMissing is false only reactivation is performed.
+type ReconcileOptions struct {
+	DeactivateMissing bool
+	MinMissedSyncs    int32
+	SeenBefore        time.Time
+}
+
+type ContentReconciliation struct {
+	Reactivated int64
+	Deactivated int64
 }
 
 type ContentRepository interface {
-	SaveOrUpdateContents(ctx context.Context, contents []entity.Content) error
-	SearchContents(ctx context.Context, filters SearchFilters, pagination Pagination) ([]entity.Content, int64, error)
+	// SaveOrUpdateContents upserts contents of a single provider and returns
+	// their database IDs keyed by provider content ID.
+	SaveOrUpdateContents(ctx context.Context, contents []entity.Content) (map[string]int64, error)
+	SearchContents(ctx context.Context, filters SearchFilters, pagination Pagination) ([]ContentMatch, int64, error)
 	GetByIDs(ctx context.Context, ids []int64) ([]entity.Content, error)
 	GetByID(ctx context.Context, id int64) (*entity.Content, error)
+	CountActiveByProvider(ctx context.Context, providerID int64) (int64, error)
+	ReconcileProviderContents(ctx context.Context, providerID int64, seenProviderContentIDs []string, opts ReconcileOptions) (ContentReconciliation, error)
+	// GetContentHashes returns the stored content hashes of a provider's
+	// contents keyed by provider content ID. Unknown IDs are left out.
+	GetContentHashes(ctx context.Context, providerID int64, providerContentIDs []string) (map[string]string, error)
+	// MarkSeen records contents as present in the latest fetch without
+	// rewriting them.
+	MarkSeen(ctx context.Context, providerID int64, providerContentIDs []string) error
+	// AutocompleteTitles returns distinct active titles with a word starting
+	// with prefix, titles starting with it first.
+	AutocompleteTitles(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error)
+	// RefreshSearchDocuments rebuilds the full-text documents of the given
+	// contents from their current titles and tags, and records their words as
+	// known search terms.
+	RefreshSearchDocuments(ctx context.Context, contentIDs []int64) error
+	// SearchFacets counts the contents matching filters per facet, keeping
+	// the tagLimit most frequent tags. Sort and RelevanceWeight are ignored.
+	SearchFacets(ctx context.Context, filters SearchFilters, tagLimit int32) (entity.SearchFacets, error)
 }
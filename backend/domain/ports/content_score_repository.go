@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+type ContentWithStats struct {
+	Content entity.Content
+	Stats   entity.ContentStats
+}
+
+type ContentScoreRepository interface {
+	SaveOrUpdateScores(ctx context.Context, scores []entity.ContentScore) error
+	GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64]entity.ContentScore, error)
+	// ListForScoring pages through active contents with their stats in ID order.
+	ListForScoring(ctx context.Context, afterID int64, limit int32) ([]ContentWithStats, error)
+}
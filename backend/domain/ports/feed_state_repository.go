@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+type FeedStateRepository interface {
+	// Get returns nil if no feed state is stored for the provider.
+	Get(ctx context.Context, providerID int64) (*entity.FeedState, error)
+	Save(ctx context.Context, state entity.FeedState) error
+}
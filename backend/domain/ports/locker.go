@@ -0,0 +1,17 @@
+package ports
+
+import "context"
+
+// Locker hands out named locks shared by every replica of the service.
+type Locker interface {
+	// TryLock acquires the named lock without waiting. It returns a nil Lock
+	// if another holder has it.
+	TryLock(ctx context.Context, key string) (Lock, error)
+}
+
+type Lock interface {
+	// Check returns an error once the lock can no longer be guaranteed, e.g.
+	// because the connection holding it was lost.
+	Check(ctx context.Context) error
+	Release(ctx context.Context) error
+}
@@ -8,4 +8,6 @@ import (
 
 type MetadataRepository interface {
 	GetContentTypeMetadata(ctx context.Context) ([]*contentpb.ContentTypeMetadata, error)
+	// GetProviderFormats returns the ids of enabled provider formats.
+	GetProviderFormats(ctx context.Context) ([]string, error)
 }
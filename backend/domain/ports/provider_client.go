@@ -2,6 +2,8 @@ package ports
 
 import (
 	"context"
+	"fmt"
+	"iter"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -22,6 +24,53 @@ type ProviderContentItem struct {
 	RawPayload        []byte
 }
 
+// ContentItems yields the items of a fetched page one at a time, in feed
+// order, as they are decoded from the response. A decoding error is yielded
+// last, with a zero item. It can be ranged over once.
+type ContentItems = iter.Seq2[ProviderContentItem, error]
+
+// PageHandler receives each fetched page while its response is read. Items
+// it leaves unread are still decoded and counted. An error stops the fetch
+// and is returned by FetchContents.
+type PageHandler func(ctx context.Context, items ContentItems) error
+
+type FetchResult struct {
+	// NotModified reports that the provider answered 304 to the validators
+	// sent; no page was handed over.
+	NotModified bool
+	// Validators are those of the fetched feed, to send on the next fetch.
+	Validators entity.FeedValidators
+	Pages      int
+	ItemCount  int
+	// Truncated reports that the fetch stopped at the provider's page limit
+	// or the item limit while the feed had more items.
+	Truncated bool
+}
+
+// ProviderStatusError is a provider response with a status other than 200
+// or 304. RetryAfter is the wait the provider asked for in its Retry-After
+// header, zero when it sent none.
+type ProviderStatusError struct {
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *ProviderStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
 type ProviderClient interface {
-	FetchContents(ctx context.Context, provider entity.Provider) ([]ProviderContentItem, error)
+	// FetchContents downloads the provider's feed page by page, following
+	// its pagination, and hands each page to handle as it arrives. Non-zero
+	// validators make the request for an unpaginated feed conditional;
+	// paginated feeds are always fetched in full.
+	FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle PageHandler) (*FetchResult, error)
+}
+
+// ProviderClientRegistry resolves the client reading each provider format.
+type ProviderClientRegistry interface {
+	// Client returns the client registered for format, if any.
+	Client(format string) (ProviderClient, bool)
+	// Formats returns the registered formats in order.
+	Formats() []string
 }
@@ -8,7 +8,13 @@ import (
 
 type ProviderRepository interface {
 	GetAllEnabled(ctx context.Context) ([]entity.Provider, error)
+	ListAll(ctx context.Context) ([]entity.Provider, error)
 	GetByCode(ctx context.Context, code string) (*entity.Provider, error)
 	GetByID(ctx context.Context, id int64) (*entity.Provider, error)
 	UpsertProvider(ctx context.Context, provider entity.Provider) error
+	// HasContents reports whether any content, active or not, was synced
+	// from the provider.
+	HasContents(ctx context.Context, id int64) (bool, error)
+	// Delete removes the provider along with its sync history.
+	Delete(ctx context.Context, id int64) error
 }
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+type SearchTermRepository interface {
+	// SuggestTerms returns known terms similar to word, most similar first.
+	SuggestTerms(ctx context.Context, word string, limit int32) ([]string, error)
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+type SyncRunRepository interface {
+	Create(ctx context.Context, run entity.SyncRun) (int64, error)
+	Update(ctx context.Context, run entity.SyncRun) error
+	ListByProvider(ctx context.Context, providerID int64, limit int32) ([]entity.SyncRun, error)
+	GetByID(ctx context.Context, id int64) (*entity.SyncRun, error)
+}
@@ -10,4 +10,10 @@ type TagRepository interface {
 	EnsureTags(ctx context.Context, tagNames []string) ([]entity.Tag, error)
 	AssignToContent(ctx context.Context, contentID int64, tagIDs []int64) error
 	GetByContentID(ctx context.Context, contentID int64) ([]entity.Tag, error)
+	GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64][]entity.Tag, error)
+	// ListWithCounts returns tags with their number of active contents, most
+	// used first.
+	ListWithCounts(ctx context.Context, contentType *entity.ContentType, limit int32) ([]entity.TagCount, error)
+	// AutocompleteTags returns tag names starting with prefix, most used first.
+	AutocompleteTags(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error)
 }
@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// Transactor runs fn inside a single database transaction. Repository writes
+// made with the context passed to fn join that transaction instead of opening
+// their own.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+const (
+	SecretSourceEnv  = "env"
+	SecretSourceFile = "file"
+)
+
+var (
+	envNamePattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	headerNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// IsSecretRef reports whether value references a secret, with an "env:" or
+// "file:" prefix, rather than holding a value.
+func IsSecretRef(value string) bool {
+	return strings.HasPrefix(value, SecretSourceEnv+":") || strings.HasPrefix(value, SecretSourceFile+":")
+}
+
+// ParseSecretRef splits a secret reference into its source, SecretSourceEnv
+// or SecretSourceFile, and the variable name or cleaned file path. Only
+// variables starting with the configured prefix and files in the configured
+// directory may be referenced.
+func ParseSecretRef(ref string, secrets entity.FeedSecretsConfig) (string, string, error) {
+	source, name, _ := strings.Cut(ref, ":")
+	switch source {
+	case SecretSourceEnv:
+		prefix := secrets.GetEnvPrefix()
+		if !envNamePattern.MatchString(name) || !strings.HasPrefix(name, prefix) || name == prefix {
+			return "", "", fmt.Errorf("secret %q must name an environment variable starting with %s", ref, prefix)
+		}
+	case SecretSourceFile:
+		name = filepath.Clean(name)
+		if !filepath.IsAbs(name) || !InDir(secrets.GetDir(), name) {
+			return "", "", fmt.Errorf("secret %q must be a file in %s", ref, secrets.GetDir())
+		}
+	default:
+		return "", "", fmt.Errorf("secrets must be given as env:NAME or file:/path references")
+	}
+	return source, name, nil
+}
+
+// InDir reports whether path lies below dir. Both are compared as given, so
+// symbolic links must be resolved by the caller.
+func InDir(dir, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func ValidateFeedAuth(auth entity.FeedAuth, secrets entity.FeedSecretsConfig) error {
+	switch auth.Kind {
+	case entity.FeedAuthNone:
+		if auth.Secret != "" || auth.Username != "" || auth.ClientID != "" || auth.TokenURL != "" {
+			return fmt.Errorf("auth settings need an auth kind")
+		}
+	case entity.FeedAuthAPIKey:
+		if auth.HeaderName != "" && auth.QueryParam != "" {
+			return fmt.Errorf("an api key goes in a header or a query parameter, not both")
+		}
+		if auth.HeaderName != "" && !headerNamePattern.MatchString(auth.HeaderName) {
+			return fmt.Errorf("invalid api key header name %q", auth.HeaderName)
+		}
+	case entity.FeedAuthBearer:
+	case entity.FeedAuthBasic:
+		if auth.Username == "" {
+			return fmt.Errorf("basic auth needs a username")
+		}
+	case entity.FeedAuthOAuth2:
+		if auth.ClientID == "" {
+			return fmt.Errorf("oauth2 client credentials need a client id")
+		}
+		tokenURL, err := url.Parse(auth.TokenURL)
+		if err != nil || (tokenURL.Scheme != "http" && tokenURL.Scheme != "https") || tokenURL.Host == "" {
+			return fmt.Errorf("oauth2 client credentials need an absolute http(s) token url")
+		}
+	default:
+		return fmt.Errorf("unknown auth kind %q", auth.Kind)
+	}
+
+	if auth.Kind != entity.FeedAuthNone {
+		if auth.Secret == "" {
+			return fmt.Errorf("%s auth needs a secret", auth.Kind)
+		}
+		if _, _, err := ParseSecretRef(auth.Secret, secrets); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range auth.Headers {
+		if !headerNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+		switch http.CanonicalHeaderKey(name) {
+		case "Host", "If-None-Match", "If-Modified-Since":
+			return fmt.Errorf("header %s is set by the client", name)
+		case "Authorization":
+			if auth.Kind != entity.FeedAuthNone && auth.Kind != entity.FeedAuthAPIKey {
+				return fmt.Errorf("header Authorization is set by %s auth", auth.Kind)
+			}
+		}
+		if err := validateRequestValue(value, secrets); err != nil {
+			return fmt.Errorf("header %s: %w", name, err)
+		}
+	}
+	for name, value := range auth.Query {
+		if name == "" {
+			return fmt.Errorf("query parameters need a name")
+		}
+		if err := validateRequestValue(value, secrets); err != nil {
+			return fmt.Errorf("query parameter %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateRequestValue(value string, secrets entity.FeedSecretsConfig) error {
+	if IsSecretRef(value) {
+		_, _, err := ParseSecretRef(value, secrets)
+		return err
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("value must be a single line")
+	}
+	return nil
+}
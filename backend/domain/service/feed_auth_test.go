@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	secrets := entity.FeedSecretsConfig{}
+	source, name, err := ParseSecretRef("env:FEED_SECRET_NEWS_API_KEY", secrets)
+	assert.NoError(t, err)
+	assert.Equal(t, SecretSourceEnv, source)
+	assert.Equal(t, "FEED_SECRET_NEWS_API_KEY", name)
+
+	source, name, err = ParseSecretRef("file:/run/secrets/feeds/news/../news-api-key", secrets)
+	assert.NoError(t, err)
+	assert.Equal(t, SecretSourceFile, source)
+	assert.Equal(t, "/run/secrets/feeds/news-api-key", name)
+
+	source, name, err = ParseSecretRef("env:NEWS_API_KEY", entity.FeedSecretsConfig{EnvPrefix: "NEWS_"})
+	assert.NoError(t, err)
+	assert.Equal(t, "NEWS_API_KEY", name)
+
+	_, _, err = ParseSecretRef("s3cr3t", secrets)
+	assert.Error(t, err)
+	_, _, err = ParseSecretRef("env:FEED_SECRET_NEWS-KEY", secrets)
+	assert.Error(t, err)
+	_, _, err = ParseSecretRef("file:secrets/news", secrets)
+	assert.Error(t, err)
+}
+
+func TestParseSecretRef_RejectsOtherSecrets(t *testing.T) {
+	secrets := entity.FeedSecretsConfig{}
+	for _, ref := range []string{
+		"env:ADMIN_API_KEY",
+		"env:DATABASE_DSN",
+		"env:FEED_SECRET_",
+		"env:feed_secret_news",
+		"file:/etc/passwd",
+		"file:/run/secrets/feeds",
+		"file:/run/secrets/feeds/../db-password",
+		"file:/run/secrets/feeds-other/news",
+	} {
+		_, _, err := ParseSecretRef(ref, secrets)
+		assert.Error(t, err, ref)
+	}
+}
+
+func TestValidateFeedAuth(t *testing.T) {
+	secrets := entity.FeedSecretsConfig{}
+
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Headers: map[string]string{"Accept": "application/json"}, Query: map[string]string{"region": "eu"}}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_NEWS_API_KEY", QueryParam: "api_key"}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "file:/run/secrets/feeds/news"}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBasic, Username: "feeds", Secret: "env:FEED_SECRET_NEWS_PASSWORD"}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthOAuth2, ClientID: "search", TokenURL: "https://auth.example.com/token", Secret: "env:FEED_SECRET_NEWS_CLIENT_SECRET"}, secrets))
+	assert.NoError(t, ValidateFeedAuth(entity.FeedAuth{Headers: map[string]string{"X-Partner-Token": "env:FEED_SECRET_PARTNER_TOKEN"}}, secrets))
+
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: "digest", Secret: "env:FEED_SECRET_KEY"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Secret: "env:FEED_SECRET_KEY"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "s3cr3t-token"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_KEY", HeaderName: "X-Key", QueryParam: "key"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBasic, Secret: "env:FEED_SECRET_PASSWORD"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthOAuth2, ClientID: "search", TokenURL: "/token", Secret: "env:FEED_SECRET_SECRET"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:FEED_SECRET_TOKEN", Headers: map[string]string{"authorization": "Bearer x"}}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Headers: map[string]string{"X Token": "x"}}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Headers: map[string]string{"X-Token": "a\r\nHost: evil"}}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Query: map[string]string{"token": "env:FEED_SECRET_bad-name"}}, secrets))
+
+	// Secrets outside the configured prefix and directory are rejected.
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:ADMIN_API_KEY"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "file:/etc/passwd"}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Headers: map[string]string{"X-Token": "env:DATABASE_DSN"}}, secrets))
+	assert.Error(t, ValidateFeedAuth(entity.FeedAuth{Query: map[string]string{"token": "file:/run/secrets/feeds/../db"}}, secrets))
+}
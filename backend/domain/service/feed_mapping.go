@@ -0,0 +1,419 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+const (
+	DateFormatRFC3339 = "rfc3339"
+	DateFormatUnix    = "unix"
+	DateFormatUnixMs  = "unix_ms"
+)
+
+type PathStepKind int
+
+const (
+	// PathKey selects an object member or the child elements named Name;
+	// "*" matches any.
+	PathKey PathStepKind = iota
+	// PathIndex selects the Index-th (from 0) array element, or element
+	// among those the previous step selected.
+	PathIndex
+	// PathEach selects every array element or object member.
+	PathEach
+	// PathAttribute selects the attribute named Name.
+	PathAttribute
+	// PathText selects an element's text.
+	PathText
+)
+
+type PathStep struct {
+	Kind  PathStepKind
+	Name  string
+	Index int
+}
+
+// FieldPath is a parsed field mapping path.
+type FieldPath []PathStep
+
+// ParseJSONPath parses the JSONPath subset used by feed mappings: "$"
+// followed by ".name", "['name']", "[n]", "[*]" or ".*" steps.
+func ParseJSONPath(expr string) (FieldPath, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(expr), "$")
+	if !ok {
+		return nil, fmt.Errorf("json path %q must start with $", expr)
+	}
+
+	var path FieldPath
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			return nil, fmt.Errorf("json path %q: recursive descent is not supported", expr)
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			rest = rest[end+1:]
+			switch {
+			case name == "*":
+				path = append(path, PathStep{Kind: PathEach})
+			case isPathName(name):
+				path = append(path, PathStep{Kind: PathKey, Name: name})
+			default:
+				return nil, fmt.Errorf("json path %q: invalid member name %q", expr, name)
+			}
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("json path %q: unclosed [", expr)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			switch {
+			case inner == "*":
+				path = append(path, PathStep{Kind: PathEach})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				path = append(path, PathStep{Kind: PathKey, Name: inner[1 : len(inner)-1]})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("json path %q: invalid index %q", expr, inner)
+				}
+				path = append(path, PathStep{Kind: PathIndex, Index: index})
+			}
+		default:
+			return nil, fmt.Errorf("json path %q: unexpected %q", expr, rest)
+		}
+	}
+	return path, nil
+}
+
+// ParseXPath parses the XPath subset used by feed mappings: "/" separated
+// element names or "*", each optionally followed by a 1-based "[n]"
+// position, and ending in an optional "@attribute" or "text()". A leading
+// "/" makes the path absolute; absolute reports whether it was.
+func ParseXPath(expr string) (path FieldPath, absolute bool, err error) {
+	rest := strings.TrimSpace(expr)
+	if rest == "" {
+		return nil, false, fmt.Errorf("xpath must not be empty")
+	}
+	if strings.Contains(rest, "//") {
+		return nil, false, fmt.Errorf("xpath %q: the descendant axis is not supported", expr)
+	}
+	rest, absolute = strings.CutPrefix(rest, "/")
+
+	segments := strings.Split(rest, "/")
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch {
+		case segment == ".":
+		case segment == "text()" && last:
+			path = append(path, PathStep{Kind: PathText})
+		case strings.HasPrefix(segment, "@") && last:
+			if !isPathName(segment[1:]) {
+				return nil, false, fmt.Errorf("xpath %q: invalid attribute %q", expr, segment)
+			}
+			path = append(path, PathStep{Kind: PathAttribute, Name: segment[1:]})
+		default:
+			name, position, hasPosition := strings.Cut(segment, "[")
+			if name != "*" && !isPathName(name) {
+				return nil, false, fmt.Errorf("xpath %q: invalid step %q", expr, segment)
+			}
+			path = append(path, PathStep{Kind: PathKey, Name: name})
+			if hasPosition {
+				index, err := strconv.Atoi(strings.TrimSuffix(position, "]"))
+				if err != nil || !strings.HasSuffix(position, "]") || index < 1 {
+					return nil, false, fmt.Errorf("xpath %q: invalid position in %q", expr, segment)
+				}
+				path = append(path, PathStep{Kind: PathIndex, Index: index - 1})
+			}
+		}
+	}
+	return path, absolute, nil
+}
+
+// ParseItemsPath parses the path selecting a feed's items into the names
+// leading to them from the document root: object members ending in an array
+// for JSON, element names from the root element down for XML.
+func ParseItemsPath(format, expr string) ([]string, error) {
+	var path FieldPath
+	switch format {
+	case entity.ProviderFormatJSON:
+		parsed, err := ParseJSONPath(expr)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(parsed); n > 0 && parsed[n-1].Kind == PathEach {
+			parsed = parsed[:n-1]
+		}
+		path = parsed
+	case entity.ProviderFormatXML:
+		parsed, absolute, err := ParseXPath(expr)
+		if err != nil {
+			return nil, err
+		}
+		if !absolute || len(parsed) == 0 {
+			return nil, fmt.Errorf("items xpath %q must be absolute", expr)
+		}
+		path = parsed
+	case entity.ProviderFormatCSV:
+		return nil, fmt.Errorf("csv feeds have no items path, every row is an item")
+	default:
+		return nil, fmt.Errorf("feed mappings are not supported for %s feeds", format)
+	}
+
+	names := make([]string, 0, len(path))
+	for _, step := range path {
+		if step.Kind != PathKey {
+			return nil, fmt.Errorf("items path %q may only name members or elements", expr)
+		}
+		names = append(names, step.Name)
+	}
+	return names, nil
+}
+
+// ParseFieldPath parses a field path, relative to an item, in the path
+// syntax of format.
+func ParseFieldPath(format, expr string) (FieldPath, error) {
+	switch format {
+	case entity.ProviderFormatJSON:
+		return ParseJSONPath(expr)
+	case entity.ProviderFormatXML:
+		path, absolute, err := ParseXPath(expr)
+		if err == nil && absolute {
+			err = fmt.Errorf("field xpath %q must be relative to the item", expr)
+		}
+		return path, err
+	case entity.ProviderFormatCSV:
+		column := strings.TrimSpace(expr)
+		if column == "" {
+			return nil, fmt.Errorf("csv column must not be empty")
+		}
+		return FieldPath{{Kind: PathKey, Name: column}}, nil
+	default:
+		return nil, fmt.Errorf("feed mappings are not supported for %s feeds", format)
+	}
+}
+
+func ValidateFeedMapping(format string, mapping entity.FeedMapping) error {
+	if format == entity.ProviderFormatCSV {
+		if mapping.Items != "" {
+			return fmt.Errorf("items mapping: csv feeds have no items path, every row is an item")
+		}
+		if _, err := CSVDelimiter(mapping.Delimiter); err != nil {
+			return err
+		}
+		return validateFieldMappings(format, mapping)
+	}
+	if mapping.Delimiter != "" {
+		return fmt.Errorf("only csv feeds take a delimiter")
+	}
+
+	if mapping.IsZero() {
+		for _, field := range mapping.Fields() {
+			if field.Mapping.Path != "" {
+				return fmt.Errorf("feed mapping needs an items path")
+			}
+		}
+		return nil
+	}
+
+	if _, err := ParseItemsPath(format, mapping.Items); err != nil {
+		return fmt.Errorf("items mapping: %w", err)
+	}
+	if mapping.ID.Path == "" || mapping.Title.Path == "" {
+		return fmt.Errorf("feed mapping needs id and title paths")
+	}
+	return validateFieldMappings(format, mapping)
+}
+
+func validateFieldMappings(format string, mapping entity.FeedMapping) error {
+	for _, field := range mapping.Fields() {
+		if field.Mapping.Path != "" {
+			if _, err := ParseFieldPath(format, field.Mapping.Path); err != nil {
+				return fmt.Errorf("%s mapping: %w", field.Name, err)
+			}
+		}
+		if field.Mapping.Format != "" {
+			if field.Name != "published_at" {
+				return fmt.Errorf("%s mapping: only published_at takes a format", field.Name)
+			}
+			if err := validateDateFormat(field.Mapping.Format); err != nil {
+				return fmt.Errorf("%s mapping: %w", field.Name, err)
+			}
+		}
+		for _, transform := range field.Mapping.Transforms {
+			if err := validateTransform(transform); err != nil {
+				return fmt.Errorf("%s mapping: %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CSVDelimiter returns the field separator a CSV feed mapping names, a comma
+// when it names none.
+func CSVDelimiter(delimiter string) (rune, error) {
+	if delimiter == "" {
+		return ',', nil
+	}
+	r, size := utf8.DecodeRuneInString(delimiter)
+	if size != len(delimiter) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+		return 0, fmt.Errorf("csv delimiter %q must be a single character other than a quote or line break", delimiter)
+	}
+	return r, nil
+}
+
+func validateDateFormat(format string) error {
+	switch format {
+	case DateFormatRFC3339, DateFormatUnix, DateFormatUnixMs:
+		return nil
+	}
+	sample := time.Date(2001, time.November, 12, 9, 30, 45, 0, time.UTC)
+	formatted := sample.Format(format)
+	if formatted == format {
+		return fmt.Errorf("date format %q has no layout elements", format)
+	}
+	if _, err := time.Parse(format, formatted); err != nil {
+		return fmt.Errorf("date format %q: %w", format, err)
+	}
+	return nil
+}
+
+func validateTransform(transform entity.ValueTransform) error {
+	switch transform.Kind {
+	case entity.TransformTrim, entity.TransformLower, entity.TransformUpper, entity.TransformDuration:
+	case entity.TransformSplit:
+		if transform.Arg == "" {
+			return fmt.Errorf("split transform needs a separator")
+		}
+	case entity.TransformMap:
+		if len(transform.Values) == 0 {
+			return fmt.Errorf("map transform needs values")
+		}
+	case entity.TransformScale:
+		if _, err := strconv.ParseFloat(transform.Arg, 64); err != nil {
+			return fmt.Errorf("scale transform needs a numeric factor, got %q", transform.Arg)
+		}
+	default:
+		return fmt.Errorf("unknown transform %q", transform.Kind)
+	}
+	return nil
+}
+
+// ApplyTransforms runs values through transforms in order.
+func ApplyTransforms(values []string, transforms []entity.ValueTransform) ([]string, error) {
+	for _, transform := range transforms {
+		next := make([]string, 0, len(values))
+		for _, value := range values {
+			switch transform.Kind {
+			case entity.TransformTrim:
+				next = append(next, strings.TrimSpace(value))
+			case entity.TransformLower:
+				next = append(next, strings.ToLower(value))
+			case entity.TransformUpper:
+				next = append(next, strings.ToUpper(value))
+			case entity.TransformSplit:
+				for _, part := range strings.Split(value, transform.Arg) {
+					if part = strings.TrimSpace(part); part != "" {
+						next = append(next, part)
+					}
+				}
+			case entity.TransformMap:
+				if mapped, ok := transform.Values[value]; ok {
+					value = mapped
+				}
+				next = append(next, value)
+			case entity.TransformScale:
+				factor, err := strconv.ParseFloat(transform.Arg, 64)
+				if err != nil {
+					return nil, fmt.Errorf("scale factor %q: %w", transform.Arg, err)
+				}
+				number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				if err != nil {
+					return nil, fmt.Errorf("scale %q: not a number", value)
+				}
+				next = append(next, strconv.FormatFloat(number*factor, 'f', -1, 64))
+			case entity.TransformDuration:
+				seconds, err := ParseDurationSeconds(value)
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, strconv.FormatInt(seconds, 10))
+			default:
+				return nil, fmt.Errorf("unknown transform %q", transform.Kind)
+			}
+		}
+		values = next
+	}
+	return values, nil
+}
+
+// ParseDurationSeconds reads "hh:mm:ss", "mm:ss", a Go duration such as
+// "1m30s" or a number of seconds.
+func ParseDurationSeconds(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if parts := strings.Split(value, ":"); len(parts) == 2 || len(parts) == 3 {
+		var seconds int64
+		for _, part := range parts {
+			n, err := strconv.ParseInt(part, 10, 64)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			seconds = seconds*60 + n
+		}
+		return seconds, nil
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		return int64(math.Round(number)), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return int64(duration.Round(time.Second) / time.Second), nil
+}
+
+// ParseMappedDate reads a date in a feed mapping format.
+func ParseMappedDate(value, format string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	switch format {
+	case "", DateFormatRFC3339:
+		return time.Parse(time.RFC3339, value)
+	case DateFormatUnix, DateFormatUnixMs:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid unix time %q", value)
+		}
+		if format == DateFormatUnixMs {
+			return time.UnixMilli(number).UTC(), nil
+		}
+		return time.Unix(number, 0).UTC(), nil
+	default:
+		return time.Parse(format, value)
+	}
+}
+
+func isPathName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '_', r == '-', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
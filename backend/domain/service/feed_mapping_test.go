@@ -0,0 +1,134 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	path, err := ParseJSONPath("$.data['the items'][*].tags[0]")
+	assert.NoError(t, err)
+	assert.Equal(t, FieldPath{
+		{Kind: PathKey, Name: "data"},
+		{Kind: PathKey, Name: "the items"},
+		{Kind: PathEach},
+		{Kind: PathKey, Name: "tags"},
+		{Kind: PathIndex, Index: 0},
+	}, path)
+
+	_, err = ParseJSONPath("data.items")
+	assert.Error(t, err)
+	_, err = ParseJSONPath("$..id")
+	assert.Error(t, err)
+	_, err = ParseJSONPath("$.items[-1]")
+	assert.Error(t, err)
+}
+
+func TestParseXPath(t *testing.T) {
+	path, absolute, err := ParseXPath("/feed/entries/entry")
+	assert.NoError(t, err)
+	assert.True(t, absolute)
+	assert.Len(t, path, 3)
+
+	path, absolute, err = ParseXPath("media/tag[2]/@name")
+	assert.NoError(t, err)
+	assert.False(t, absolute)
+	assert.Equal(t, FieldPath{
+		{Kind: PathKey, Name: "media"},
+		{Kind: PathKey, Name: "tag"},
+		{Kind: PathIndex, Index: 1},
+		{Kind: PathAttribute, Name: "name"},
+	}, path)
+
+	_, _, err = ParseXPath("//entry")
+	assert.Error(t, err)
+	_, _, err = ParseXPath("tag[0]")
+	assert.Error(t, err)
+	_, _, err = ParseXPath("@id/title")
+	assert.Error(t, err)
+}
+
+func TestValidateFeedMapping(t *testing.T) {
+	valid := entity.FeedMapping{
+		Items:       "$.data.items",
+		ID:          entity.FieldMapping{Path: "$.uid"},
+		Title:       entity.FieldMapping{Path: "$.name"},
+		PublishedAt: entity.FieldMapping{Path: "$.created", Format: DateFormatUnix},
+	}
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatJSON, entity.FeedMapping{}))
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatJSON, valid))
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatXML, entity.FeedMapping{
+		Items: "/catalog/entry",
+		ID:    entity.FieldMapping{Path: "@id"},
+		Title: entity.FieldMapping{Path: "title"},
+	}))
+
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatCSV, entity.FeedMapping{}))
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatCSV, entity.FeedMapping{Delimiter: "\t", ID: entity.FieldMapping{Path: "Ref"}}))
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatCSV, entity.FeedMapping{Items: "$.rows"}))
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatCSV, entity.FeedMapping{Delimiter: ";;"}))
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatCSV, entity.FeedMapping{Delimiter: `"`}))
+	assert.EqualError(t, ValidateFeedMapping(entity.ProviderFormatJSON, entity.FeedMapping{Delimiter: ";"}), "only csv feeds take a delimiter")
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatRSS, valid))
+
+	withoutTitle := valid
+	withoutTitle.Title = entity.FieldMapping{}
+	assert.EqualError(t, ValidateFeedMapping(entity.ProviderFormatJSON, withoutTitle), "feed mapping needs id and title paths")
+
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatJSON, entity.FeedMapping{ID: entity.FieldMapping{Path: "$.id"}}))
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatXML, entity.FeedMapping{
+		Items: "catalog/entry",
+		ID:    entity.FieldMapping{Path: "@id"},
+		Title: entity.FieldMapping{Path: "title"},
+	}))
+
+	formatted := valid
+	formatted.Views = entity.FieldMapping{Path: "$.views", Format: DateFormatUnix}
+	assert.EqualError(t, ValidateFeedMapping(entity.ProviderFormatJSON, formatted), "views mapping: only published_at takes a format")
+
+	literal := valid
+	literal.PublishedAt = entity.FieldMapping{Path: "$.created", Format: "yesterday"}
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatJSON, literal))
+	layout := valid
+	layout.PublishedAt = entity.FieldMapping{Path: "$.created", Format: "2006-01-02 15:04"}
+	assert.NoError(t, ValidateFeedMapping(entity.ProviderFormatJSON, layout))
+
+	transformed := valid
+	transformed.Type = entity.FieldMapping{Path: "$.kind", Transforms: []entity.ValueTransform{{Kind: "reverse"}}}
+	assert.Error(t, ValidateFeedMapping(entity.ProviderFormatJSON, transformed))
+}
+
+func TestApplyTransforms(t *testing.T) {
+	values, err := ApplyTransforms([]string{" Go, Backend ,"}, []entity.ValueTransform{
+		{Kind: entity.TransformSplit, Arg: ","},
+		{Kind: entity.TransformLower},
+		{Kind: entity.TransformMap, Values: map[string]string{"go": "golang"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"golang", "backend"}, values)
+
+	values, err = ApplyTransforms([]string{"1.5"}, []entity.ValueTransform{{Kind: entity.TransformScale, Arg: "60"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"90"}, values)
+
+	_, err = ApplyTransforms([]string{"many"}, []entity.ValueTransform{{Kind: entity.TransformScale, Arg: "60"}})
+	assert.Error(t, err)
+}
+
+func TestParseDurationSeconds(t *testing.T) {
+	for value, want := range map[string]int64{
+		"01:02:03": 3723,
+		"4:05":     245,
+		"1m30s":    90,
+		"42":       42,
+	} {
+		seconds, err := ParseDurationSeconds(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, want, seconds, value)
+	}
+
+	_, err := ParseDurationSeconds("soon")
+	assert.Error(t, err)
+}
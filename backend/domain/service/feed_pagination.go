@@ -0,0 +1,45 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+)
+
+const (
+	maxFeedPages       = 1000
+	maxFeedPageRetries = 10
+)
+
+func ValidateFeedPagination(pagination entity.FeedPagination) error {
+	switch pagination.Strategy {
+	case entity.PaginationNone, entity.PaginationLink:
+	case entity.PaginationPage, entity.PaginationOffset:
+		if pagination.Param == "" {
+			return fmt.Errorf("%s pagination needs a query parameter", pagination.Strategy)
+		}
+	case entity.PaginationCursor:
+		if pagination.Param == "" || pagination.CursorField == "" {
+			return fmt.Errorf("cursor pagination needs a query parameter and a cursor field")
+		}
+	default:
+		return fmt.Errorf("unknown pagination strategy %q", pagination.Strategy)
+	}
+
+	if pagination.PageSize < 0 {
+		return fmt.Errorf("page size must not be negative")
+	}
+	if pagination.SizeParam != "" && pagination.PageSize == 0 {
+		return fmt.Errorf("page size parameter needs a page size")
+	}
+	if pagination.FirstPage < 0 {
+		return fmt.Errorf("first page must not be negative")
+	}
+	if pagination.MaxPages < 0 || pagination.MaxPages > maxFeedPages {
+		return fmt.Errorf("max pages must be between 0 and %d", maxFeedPages)
+	}
+	if pagination.PageRetries < 0 || pagination.PageRetries > maxFeedPageRetries {
+		return fmt.Errorf("page retries must be between 0 and %d", maxFeedPageRetries)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFeedPagination(t *testing.T) {
+	assert.NoError(t, ValidateFeedPagination(entity.FeedPagination{}))
+	assert.NoError(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationLink}))
+	assert.NoError(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationPage, Param: "page", SizeParam: "per_page", PageSize: 50}))
+	assert.NoError(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "after", CursorField: "next_cursor"}))
+
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{Strategy: "scroll"}))
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationOffset}))
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "after"}))
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{Strategy: entity.PaginationPage, Param: "page", SizeParam: "per_page"}))
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{MaxPages: maxFeedPages + 1}))
+	assert.Error(t, ValidateFeedPagination(entity.FeedPagination{PageRetries: -1}))
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"sync"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -9,6 +10,7 @@ import (
 type TimeProvider func() time.Time
 
 type ScoringService struct {
+	mu           sync.RWMutex
 	config       entity.ScoringConfig
 	timeProvider TimeProvider
 }
@@ -20,16 +22,31 @@ func NewScoringService(config entity.ScoringConfig, timeProvider TimeProvider) *
 	}
 }
 
+// UpdateConfig swaps the scoring rules and reports whether they changed.
+func (s *ScoringService) UpdateConfig(config entity.ScoringConfig) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.config == config {
+		return false
+	}
+	s.config = config
+	return true
+}
+
 func (s *ScoringService) Calculate(content entity.Content, stats entity.ContentStats) entity.ScoreComponents {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	now := s.timeProvider()
-	
+
 	baseScore := s.computeBaseScore(content, stats)
 	typeMultiplier := s.getTypeMultiplier(content)
 	recencyScore := s.computeRecencyScore(content, now)
 	engagementScore := s.computeEngagementScore(content, stats)
-	
+
 	finalScore := (baseScore * typeMultiplier) + recencyScore + engagementScore
-	
+
 	return entity.ScoreComponents{
 		BaseScore:       baseScore,
 		TypeMultiplier:  typeMultiplier,
@@ -39,6 +56,24 @@ func (s *ScoringService) Calculate(content entity.Content, stats entity.ContentS
 	}
 }
 
+// WithRelevance blends a full-text rank into a score. The search query orders
+// by the same expression, so page order and scores agree.
+func (s *ScoringService) WithRelevance(score entity.ScoreComponents, relevance float64) entity.ScoreComponents {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	score.RelevanceScore = relevance * s.config.RelevanceWeight
+	score.FinalScore += score.RelevanceScore
+	return score
+}
+
+func (s *ScoringService) RelevanceWeight() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.config.RelevanceWeight
+}
+
 func (s *ScoringService) computeBaseScore(content entity.Content, stats entity.ContentStats) float64 {
 	switch content.ContentType {
 	case entity.ContentTypeVideo:
@@ -50,11 +85,11 @@ func (s *ScoringService) computeBaseScore(content entity.Content, stats entity.C
 		if likesDivisor == 0 {
 			likesDivisor = 1.0
 		}
-		
+
 		viewsScore := float64(stats.Views) / viewsDivisor
 		likesScore := float64(stats.Likes) / likesDivisor
 		return viewsScore + likesScore
-		
+
 	case entity.ContentTypeArticle:
 		readingTimeDivisor := s.config.TextReadingTimeDivisor
 		if readingTimeDivisor == 0 {
@@ -64,11 +99,11 @@ func (s *ScoringService) computeBaseScore(content entity.Content, stats entity.C
 		if reactionsDivisor == 0 {
 			reactionsDivisor = 1.0
 		}
-		
+
 		readingTimeScore := float64(stats.ReadingTime) / readingTimeDivisor
 		reactionsScore := float64(stats.Reactions) / reactionsDivisor
 		return readingTimeScore + reactionsScore
-		
+
 	default:
 		return 0.0
 	}
@@ -88,13 +123,13 @@ func (s *ScoringService) getTypeMultiplier(content entity.Content) float64 {
 func (s *ScoringService) computeRecencyScore(content entity.Content, now time.Time) float64 {
 	elapsed := now.Sub(content.PublishedAt)
 	daysSincePublish := elapsed.Hours() / 24.0
-	
+
 	const (
 		week    = 7.0
 		month   = 30.0
 		quarter = 90.0
 	)
-	
+
 	if daysSincePublish <= week {
 		return s.config.RecencyWeekScore
 	} else if daysSincePublish <= month {
@@ -102,7 +137,7 @@ func (s *ScoringService) computeRecencyScore(content entity.Content, now time.Ti
 	} else if daysSincePublish <= quarter {
 		return s.config.RecencyQuarterScore
 	}
-	
+
 	return 0.0
 }
 
@@ -114,14 +149,14 @@ func (s *ScoringService) computeEngagementScore(content entity.Content, stats en
 		}
 		ratio := float64(stats.Likes) / float64(stats.Views)
 		return ratio * s.config.VideoEngagementWeight
-		
+
 	case entity.ContentTypeArticle:
 		if stats.ReadingTime == 0 {
 			return 0.0
 		}
 		ratio := float64(stats.Reactions) / float64(stats.ReadingTime)
 		return ratio * s.config.TextEngagementWeight
-		
+
 	default:
 		return 0.0
 	}
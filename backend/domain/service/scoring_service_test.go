@@ -67,7 +67,7 @@ func TestScoringService_Calculate(t *testing.T) {
 			expected: entity.ScoreComponents{
 				BaseScore:       (300.0 / 60.0) + (20.0 / 5.0), // 5 + 4 = 9
 				TypeMultiplier:  1.2,
-				RecencyScore:    2.0, // Quarter score
+				RecencyScore:    2.0,                  // Quarter score
 				EngagementScore: (20.0 / 300.0) * 1.5, // 0.0666... * 1.5 = 0.1
 				// Final: (9 * 1.2) + 2 + 0.1 = 10.8 + 2 + 0.1 = 12.9
 				FinalScore: 12.9,
@@ -97,7 +97,7 @@ func TestScoringService_Calculate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result := service.Calculate(tt.content, tt.stats)
-			
+
 			// Use epsilon for float comparison
 			epsilon := 0.0001
 			assert.InDelta(t, tt.expected.BaseScore, result.BaseScore, epsilon, "BaseScore mismatch")
@@ -112,15 +112,15 @@ func TestScoringService_Calculate(t *testing.T) {
 func TestScoringService_Recency(t *testing.T) {
 	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	timeProvider := func() time.Time { return now }
-	
+
 	config := entity.ScoringConfig{
 		RecencyWeekScore:    10.0,
 		RecencyMonthScore:   5.0,
 		RecencyQuarterScore: 2.0,
 	}
-	
+
 	service := NewScoringService(config, timeProvider)
-	
+
 	tests := []struct {
 		name        string
 		publishedAt time.Time
@@ -134,17 +134,31 @@ func TestScoringService_Recency(t *testing.T) {
 		{"89 Days Ago", now.Add(-89 * 24 * time.Hour), 2.0},
 		{"91 Days Ago", now.Add(-91 * 24 * time.Hour), 0.0},
 	}
-	
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			content := entity.Content{PublishedAt: tt.publishedAt}
 			// Access private method via Calculate or just trust Calculate uses it?
 			// Since we are testing public API, we check the component in Calculate result.
 			// But to isolate, we can rely on Calculate's RecencyScore field.
-			
+
 			// We need dummy stats/type to call Calculate
 			res := service.Calculate(content, entity.ContentStats{})
 			assert.Equal(t, tt.expected, res.RecencyScore)
 		})
 	}
 }
+
+func TestScoringService_UpdateConfig(t *testing.T) {
+	now := time.Date(2023, 10, 25, 12, 0, 0, 0, time.UTC)
+	config := entity.ScoringConfig{RecencyWeekScore: 5.0}
+	service := NewScoringService(config, func() time.Time { return now })
+
+	assert.False(t, service.UpdateConfig(config))
+
+	config.RecencyWeekScore = 8.0
+	assert.True(t, service.UpdateConfig(config))
+
+	res := service.Calculate(entity.Content{PublishedAt: now}, entity.ContentStats{})
+	assert.Equal(t, 8.0, res.RecencyScore)
+}
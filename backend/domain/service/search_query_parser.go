@@ -0,0 +1,106 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ParseSearchQuery converts user input into a PostgreSQL to_tsquery
+// expression. All terms must match; the supported syntax is:
+//
+//	word     stemmed match
+//	"a b"    phrase, words must be adjacent
+//	word*    prefix match
+//	-word    exclude (also works for phrases)
+//
+// Anything else is treated as a word separator, so the result is always safe
+// to pass to to_tsquery. An empty string means there is nothing to search for.
+func ParseSearchQuery(input string) string {
+	var clauses []string
+	for _, token := range tokenizeQuery(input) {
+		clause := phraseClause(token.words, token.prefix)
+		if token.negate {
+			clause = "!" + clause
+		}
+		clauses = append(clauses, clause)
+	}
+	return strings.Join(clauses, " & ")
+}
+
+// QueryTerms returns the lowercased words a query searches for, in order,
+// leaving out excluded words and query syntax.
+func QueryTerms(input string) []string {
+	var terms []string
+	for _, token := range tokenizeQuery(input) {
+		if !token.negate {
+			terms = append(terms, token.words...)
+		}
+	}
+	return terms
+}
+
+type queryToken struct {
+	words  []string
+	negate bool
+	prefix bool
+}
+
+func tokenizeQuery(input string) []queryToken {
+	var tokens []queryToken
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		var token queryToken
+		if runes[i] == '-' {
+			token.negate = true
+			i++
+		}
+
+		if i < len(runes) && runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			token.words = lexemes(string(runes[i+1 : end]))
+			i = end + 1
+		} else {
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			token.prefix = strings.HasSuffix(word, "*")
+			token.words = lexemes(word)
+			i = end
+		}
+
+		if len(token.words) > 0 {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// phraseClause joins words with the followed-by operator. Words split from a
+// single token (e.g. "e-mail") are kept adjacent the same way.
+func phraseClause(words []string, prefix bool) string {
+	if prefix {
+		words[len(words)-1] += ":*"
+	}
+	if len(words) == 1 {
+		return words[0]
+	}
+	return "(" + strings.Join(words, " <-> ") + ")"
+}
+
+func lexemes(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
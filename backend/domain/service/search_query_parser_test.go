@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Empty", "   ", ""},
+		{"Single Word", "Golang", "golang"},
+		{"Multiple Words", "go  programming", "go & programming"},
+		{"Phrase", `"clean architecture" go`, "(clean <-> architecture) & go"},
+		{"Prefix", "prog*", "prog:*"},
+		{"Exclude", "go -java", "go & !java"},
+		{"Exclude Phrase", `-"hello world"`, "!(hello <-> world)"},
+		{"Hyphenated Word", "e-mail", "(e <-> mail)"},
+		{"Operators Are Stripped", "a&b | !c:*", "(a <-> b) & c:*"},
+		{"Unterminated Phrase", `"deep learning`, "(deep <-> learning)"},
+		{"Only Punctuation", "!!! ???", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseSearchQuery(tt.input))
+		})
+	}
+}
+func TestQueryTerms(t *testing.T) {
+	assert.Equal(t, []string{"clean", "architecture", "go"}, QueryTerms(`"Clean Architecture" go* -java`))
+	assert.Empty(t, QueryTerms("-java"))
+}
@@ -0,0 +1,42 @@
+package service
+
+import "strings"
+
+// BuildSuggestions proposes corrected queries by replacing each term with its
+// closest known terms. candidates holds, per term, known terms ordered from
+// most to least similar; a term whose best candidate is itself is left as is.
+// The n-th suggestion uses the n-th candidate of every misspelled term.
+func BuildSuggestions(terms []string, candidates map[string][]string, limit int) []string {
+	original := strings.Join(terms, " ")
+	suggestions := make([]string, 0, limit)
+	seen := map[string]bool{original: true}
+
+	for rank := 0; len(suggestions) < limit; rank++ {
+		corrected := make([]string, len(terms))
+		exhausted := true
+		for i, term := range terms {
+			options := candidates[term]
+			if len(options) == 0 || options[0] == term {
+				corrected[i] = term
+				continue
+			}
+			if rank < len(options) {
+				exhausted = false
+				corrected[i] = options[rank]
+			} else {
+				corrected[i] = options[len(options)-1]
+			}
+		}
+		if exhausted {
+			break
+		}
+
+		suggestion := strings.Join(corrected, " ")
+		if !seen[suggestion] {
+			seen[suggestion] = true
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	return suggestions
+}
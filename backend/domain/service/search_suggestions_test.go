@@ -0,0 +1,33 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSuggestions(t *testing.T) {
+	t.Run("Corrects Misspelled Terms", func(t *testing.T) {
+		candidates := map[string][]string{
+			"iphnoe": {"iphone", "iphones"},
+			"case":   {"case", "cases"},
+		}
+		suggestions := BuildSuggestions([]string{"iphnoe", "case"}, candidates, 3)
+		assert.Equal(t, []string{"iphone case", "iphones case"}, suggestions)
+	})
+
+	t.Run("Respects Limit", func(t *testing.T) {
+		candidates := map[string][]string{
+			"gola": {"golang", "gold", "goal"},
+		}
+		suggestions := BuildSuggestions([]string{"gola"}, candidates, 2)
+		assert.Equal(t, []string{"golang", "gold"}, suggestions)
+	})
+
+	t.Run("Known Terms Yield Nothing", func(t *testing.T) {
+		candidates := map[string][]string{
+			"golang": {"golang"},
+		}
+		assert.Empty(t, BuildSuggestions([]string{"golang", "unknownword"}, candidates, 3))
+	})
+}
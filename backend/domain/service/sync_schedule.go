@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/robfig/cron/v3"
+)
+
+// SyncPlanner computes when a provider is next due for a sync.
+type SyncPlanner struct {
+	defaultInterval time.Duration
+	jitter          func(max time.Duration) time.Duration
+}
+
+// NewSyncPlanner returns a planner that falls back to defaultInterval for
+// providers without a schedule. jitter picks a delay in [0, max); nil uses a
+// uniform random delay.
+func NewSyncPlanner(defaultInterval time.Duration, jitter func(max time.Duration) time.Duration) *SyncPlanner {
+	if jitter == nil {
+		jitter = func(max time.Duration) time.Duration {
+			return rand.N(max)
+		}
+	}
+	return &SyncPlanner{
+		defaultInterval: defaultInterval,
+		jitter:          jitter,
+	}
+}
+
+// Next returns the first sync time after from. Cron expressions are evaluated
+// in from's location unless they carry a CRON_TZ= prefix.
+func (p *SyncPlanner) Next(schedule entity.SyncSchedule, from time.Time) (time.Time, error) {
+	var next time.Time
+	switch {
+	case schedule.Cron != "":
+		parsed, err := cron.ParseStandard(schedule.Cron)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parse cron expression %q: %w", schedule.Cron, err)
+		}
+		next = parsed.Next(from)
+	case schedule.Interval > 0:
+		next = from.Add(schedule.Interval)
+	default:
+		next = from.Add(p.defaultInterval)
+	}
+
+	if schedule.Jitter > 0 {
+		next = next.Add(p.jitter(schedule.Jitter))
+	}
+	return next, nil
+}
+
+func ValidateSyncSchedule(schedule entity.SyncSchedule) error {
+	if schedule.Interval < 0 {
+		return fmt.Errorf("sync interval must not be negative")
+	}
+	if schedule.Jitter < 0 {
+		return fmt.Errorf("sync jitter must not be negative")
+	}
+	if schedule.Cron != "" {
+		if _, err := cron.ParseStandard(schedule.Cron); err != nil {
+			return fmt.Errorf("invalid cron expression %q: %w", schedule.Cron, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncPlanner_Next(t *testing.T) {
+	planner := NewSyncPlanner(time.Minute, func(max time.Duration) time.Duration {
+		return max / 2
+	})
+	from := time.Date(2024, 5, 1, 10, 17, 30, 0, time.UTC)
+
+	t.Run("Default Interval", func(t *testing.T) {
+		next, err := planner.Next(entity.SyncSchedule{}, from)
+		assert.NoError(t, err)
+		assert.Equal(t, from.Add(time.Minute), next)
+	})
+
+	t.Run("Interval With Jitter", func(t *testing.T) {
+		next, err := planner.Next(entity.SyncSchedule{Interval: time.Hour, Jitter: 10 * time.Minute}, from)
+		assert.NoError(t, err)
+		assert.Equal(t, from.Add(time.Hour+5*time.Minute), next)
+	})
+
+	t.Run("Cron Takes Precedence", func(t *testing.T) {
+		next, err := planner.Next(entity.SyncSchedule{Interval: time.Minute, Cron: "0 6 * * *"}, from)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 2, 6, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("Invalid Cron", func(t *testing.T) {
+		_, err := planner.Next(entity.SyncSchedule{Cron: "every day"}, from)
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateSyncSchedule(t *testing.T) {
+	assert.NoError(t, ValidateSyncSchedule(entity.SyncSchedule{}))
+	assert.NoError(t, ValidateSyncSchedule(entity.SyncSchedule{Cron: "@hourly", Jitter: time.Minute}))
+	assert.Error(t, ValidateSyncSchedule(entity.SyncSchedule{Interval: -time.Second}))
+	assert.Error(t, ValidateSyncSchedule(entity.SyncSchedule{Jitter: -time.Second}))
+	assert.Error(t, ValidateSyncSchedule(entity.SyncSchedule{Cron: "61 * * * *"}))
+}
@@ -43,6 +43,7 @@ func (p *DatabaseConfigProvider) GetScoringConfig() entity.ScoringConfig {
 		VideoLikesDivisor:      100.0,
 		TextReadingTimeDivisor: 1.0,
 		TextReactionsDivisor:   50.0,
+		RelevanceWeight:        10.0,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -70,6 +71,9 @@ func (p *DatabaseConfigProvider) GetScoringConfig() entity.ScoringConfig {
 		MonthScore   float64 `json:"month_score"`
 		QuarterScore float64 `json:"quarter_score"`
 	}
+	type RelevanceConfig struct {
+		Weight float64 `json:"weight"`
+	}
 
 	for key, value := range rules {
 		switch key {
@@ -96,6 +100,11 @@ func (p *DatabaseConfigProvider) GetScoringConfig() entity.ScoringConfig {
 				config.RecencyMonthScore = rc.MonthScore
 				config.RecencyQuarterScore = rc.QuarterScore
 			}
+		case "relevance_config":
+			var rc RelevanceConfig
+			if err := json.Unmarshal(value, &rc); err == nil {
+				config.RelevanceWeight = rc.Weight
+			}
 		}
 	}
 
@@ -0,0 +1,115 @@
+package providers
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+type CsvProviderClient struct {
+	client *feedHTTPClient
+	limits feedLimits
+}
+
+func NewCsvProviderClient(config entity.SyncConfig, secrets entity.FeedSecretsConfig) ports.ProviderClient {
+	return &CsvProviderClient{
+		client: newFeedHTTPClient(secrets),
+		limits: newFeedLimits(config),
+	}
+}
+
+// FetchContents reads a CSV feed whose first row is its header. Columns are
+// read through the provider's feed mapping; without one, each field reads
+// the column named after it.
+func (p *CsvProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	mapping, err := compileMapping(entity.ProviderFormatCSV, provider.Mapping)
+	if err != nil {
+		return nil, fmt.Errorf("compile feed mapping: %w", err)
+	}
+	delimiter, err := service.CSVDelimiter(provider.Mapping.Delimiter)
+	if err != nil {
+		return nil, fmt.Errorf("compile feed mapping: %w", err)
+	}
+	return fetchFeed(ctx, p.client, p.limits, provider, validators, decodeCSVPage(mapping, delimiter), handle)
+}
+
+// decodeCSVPage reads a page one row at a time. CSV feeds carry no
+// pagination cursor, so the cursor field is ignored.
+func decodeCSVPage(mapping *compiledMapping, delimiter rune) pageDecoder {
+	return func(body io.Reader, _ string, yield func(ports.ProviderContentItem) bool) (string, error) {
+		reader := csv.NewReader(body)
+		reader.Comma = delimiter
+		reader.TrimLeadingSpace = true
+		reader.ReuseRecord = true
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("decode csv header: %w", err)
+		}
+
+		header := make([]string, len(record))
+		columns := make(map[string]int, len(record))
+		for i, name := range record {
+			name = strings.TrimSpace(name)
+			if i == 0 {
+				name = strings.TrimPrefix(name, "\ufeff")
+			}
+			header[i] = name
+			if _, ok := columns[name]; !ok {
+				columns[name] = i
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(mapping.fields)) {
+			field := mapping.fields[name]
+			column := field.path[0].Name
+			if _, ok := columns[column]; !ok && !field.optional {
+				return "", fmt.Errorf("decode csv header: no %q column", column)
+			}
+		}
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return "", nil
+			}
+			if err != nil {
+				return "", fmt.Errorf("decode csv: %w", err)
+			}
+			line, _ := reader.FieldPos(0)
+
+			item, err := mapping.mapItem(func(path service.FieldPath) []string {
+				index, ok := columns[path[0].Name]
+				if !ok || record[index] == "" {
+					return nil
+				}
+				return []string{record[index]}
+			})
+			if err != nil {
+				return "", fmt.Errorf("map line %d: %w", line, err)
+			}
+
+			payload := make(map[string]string, len(header))
+			for i, name := range header {
+				payload[name] = record[i]
+			}
+			item.RawPayload, _ = json.Marshal(payload)
+
+			if !yield(item) {
+				return "", nil
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCsvProviderClient_FetchContents(t *testing.T) {
+	mockResponse := "\ufeffid,title,type,views,likes,published_at,tags\n" +
+		"c1,\"Intro, Part 1\",video,100,7,2024-01-02T03:04:05Z,go|csv\n" +
+		"\n" +
+		"c2,Second,,,,,\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		Format:  entity.ProviderFormatCSV,
+		BaseURL: server.URL,
+		Mapping: entity.FeedMapping{
+			Type: entity.FieldMapping{Default: "article"},
+			Tags: entity.FieldMapping{Transforms: []entity.ValueTransform{{Kind: entity.TransformSplit, Arg: "|"}}},
+		},
+	}
+
+	var items []ports.ProviderContentItem
+	_, err := NewCsvProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2"}, itemIDs(items))
+
+	item := items[0]
+	assert.Equal(t, "Intro, Part 1", item.Title)
+	assert.Equal(t, "video", item.ContentType)
+	assert.Equal(t, int64(100), item.Views)
+	assert.Equal(t, int64(7), item.Likes)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), item.PublishedAt)
+	assert.Equal(t, []string{"go", "csv"}, item.Tags)
+	assert.JSONEq(t, `{"id": "c1", "title": "Intro, Part 1", "type": "video", "views": "100", "likes": "7",
+		"published_at": "2024-01-02T03:04:05Z", "tags": "go|csv"}`, string(item.RawPayload))
+
+	assert.Equal(t, "article", items[1].ContentType)
+	assert.Empty(t, items[1].Tags)
+}
+
+func TestCsvProviderClient_HeaderMapping(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Ref;Headline;Minutes\nr1;Semicolons;2,5\nr2;Broken\n"))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		Format:  entity.ProviderFormatCSV,
+		BaseURL: server.URL,
+		Mapping: entity.FeedMapping{
+			Delimiter: ";",
+			ID:        entity.FieldMapping{Path: "Ref"},
+			Title:     entity.FieldMapping{Path: "Headline"},
+			ReadingTime: entity.FieldMapping{Path: "Minutes", Transforms: []entity.ValueTransform{
+				{Kind: entity.TransformMap, Values: map[string]string{"2,5": "2.5"}},
+				{Kind: entity.TransformScale, Arg: "2"},
+			}},
+		},
+	}
+
+	var items []ports.ProviderContentItem
+	_, err := NewCsvProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.ErrorContains(t, err, "decode csv: record on line 3: wrong number of fields")
+	assert.Equal(t, []string{"r1"}, itemIDs(items))
+	assert.Equal(t, int32(5), items[0].ReadingTime)
+
+	provider.Mapping.Title.Path = "Name"
+	_, err = NewCsvProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+	assert.EqualError(t, err, `page 1: decode csv header: no "Name" column`)
+}
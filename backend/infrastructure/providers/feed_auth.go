@@ -0,0 +1,274 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+// oauthTokenExpiryMargin renews a token this long before it expires, so it
+// does not expire while a page is requested.
+const oauthTokenExpiryMargin = 30 * time.Second
+
+const maxOAuthTokenResponseBytes = 1 << 20
+
+// authorize adds the provider's auth, headers and query parameters to req.
+// Requests to other hosts than that of the provider's base URL, such as a
+// Link header's next page, are sent without them.
+func (c *feedHTTPClient) authorize(ctx context.Context, req *http.Request, provider entity.Provider) error {
+	auth := provider.Auth
+	if auth.IsZero() {
+		return nil
+	}
+	baseURL, err := url.Parse(provider.BaseURL)
+	if err != nil || !strings.EqualFold(baseURL.Host, req.URL.Host) {
+		return nil
+	}
+
+	query := req.URL.Query()
+	for name, value := range auth.Query {
+		resolved, err := resolveValue(value, c.secrets)
+		if err != nil {
+			return fmt.Errorf("query parameter %s: %w", name, err)
+		}
+		query.Set(name, resolved)
+	}
+	for name, value := range auth.Headers {
+		resolved, err := resolveValue(value, c.secrets)
+		if err != nil {
+			return fmt.Errorf("header %s: %w", name, err)
+		}
+		req.Header.Set(name, resolved)
+	}
+
+	switch auth.Kind {
+	case entity.FeedAuthAPIKey:
+		key, err := resolveSecret(auth.Secret, c.secrets)
+		if err != nil {
+			return err
+		}
+		if auth.QueryParam != "" {
+			query.Set(auth.QueryParam, key)
+		} else {
+			req.Header.Set(auth.GetHeaderName(), key)
+		}
+	case entity.FeedAuthBearer:
+		token, err := resolveSecret(auth.Secret, c.secrets)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case entity.FeedAuthBasic:
+		password, err := resolveSecret(auth.Secret, c.secrets)
+		if err != nil {
+			return err
+		}
+		req.SetBasicAuth(auth.Username, password)
+	case entity.FeedAuthOAuth2:
+		token, err := c.tokens.token(ctx, auth)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if len(query) > 0 {
+		req.URL.RawQuery = query.Encode()
+	}
+	return nil
+}
+
+// resolveSecret reads the secret a reference points to. Errors name the
+// reference, never the secret.
+func resolveSecret(ref string, secrets entity.FeedSecretsConfig) (string, error) {
+	source, name, err := service.ParseSecretRef(ref, secrets)
+	if err != nil {
+		return "", err
+	}
+
+	if source == service.SecretSourceEnv {
+		value := os.Getenv(name)
+		if value == "" {
+			return "", fmt.Errorf("secret %s is not set", ref)
+		}
+		return value, nil
+	}
+
+	// A link in the secrets directory must not lead out of it. The directory
+	// itself may be a link, as mounted secrets often are.
+	path, err := filepath.EvalSymlinks(name)
+	if err != nil {
+		return "", fmt.Errorf("read secret %s: %w", ref, err)
+	}
+	dir, err := filepath.EvalSymlinks(secrets.GetDir())
+	if err != nil {
+		return "", fmt.Errorf("read secret %s: %w", ref, err)
+	}
+	if !service.InDir(dir, path) {
+		return "", fmt.Errorf("secret %s links outside %s", ref, secrets.GetDir())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read secret %s: %w", ref, err)
+	}
+	value := strings.TrimRight(string(data), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("secret %s is empty", ref)
+	}
+	return value, nil
+}
+
+// redactURLError drops the query and user info from the URL a transport
+// error names, as authorize may have put secrets there. Fetch errors end up
+// in logs and in sync runs.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	redacted, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		urlErr.URL = ""
+		return err
+	}
+	redacted.User = nil
+	redacted.RawQuery = ""
+	redacted.Fragment = ""
+	urlErr.URL = redacted.String()
+	return err
+}
+
+// resolveValue resolves a header or query value that references a secret
+// and returns any other value as is.
+func resolveValue(value string, secrets entity.FeedSecretsConfig) (string, error) {
+	if service.IsSecretRef(value) {
+		return resolveSecret(value, secrets)
+	}
+	return value, nil
+}
+
+type oauthToken struct {
+	accessToken string
+	// expiresAt is zero when the token endpoint gave no lifetime.
+	expiresAt time.Time
+}
+
+// oauthTokens caches the tokens of OAuth2 client credentials until they
+// expire.
+type oauthTokens struct {
+	client  *http.Client
+	secrets entity.FeedSecretsConfig
+
+	mu     sync.Mutex
+	tokens map[string]oauthToken
+}
+
+func newOAuthTokens(client *http.Client, secrets entity.FeedSecretsConfig) *oauthTokens {
+	return &oauthTokens{
+		client:  client,
+		secrets: secrets,
+		tokens:  make(map[string]oauthToken),
+	}
+}
+
+func oauthTokenKey(auth entity.FeedAuth) string {
+	return strings.Join([]string{auth.TokenURL, auth.ClientID, auth.Secret, strings.Join(auth.Scopes, " ")}, "\x00")
+}
+
+func (t *oauthTokens) token(ctx context.Context, auth entity.FeedAuth) (string, error) {
+	key := oauthTokenKey(auth)
+	t.mu.Lock()
+	token, ok := t.tokens[key]
+	t.mu.Unlock()
+	if ok && (token.expiresAt.IsZero() || time.Now().Before(token.expiresAt)) {
+		return token.accessToken, nil
+	}
+
+	token, err := t.request(ctx, auth)
+	if err != nil {
+		return "", err
+	}
+
+	t.mu.Lock()
+	t.tokens[key] = token
+	t.mu.Unlock()
+	return token.accessToken, nil
+}
+
+// invalidate drops the cached token of auth, so the next request gets a new
+// one.
+func (t *oauthTokens) invalidate(auth entity.FeedAuth) {
+	t.mu.Lock()
+	delete(t.tokens, oauthTokenKey(auth))
+	t.mu.Unlock()
+}
+
+// request runs the client credentials grant of RFC 6749, section 4.4,
+// authenticating the client with HTTP basic auth.
+func (t *oauthTokens) request(ctx context.Context, auth entity.FeedAuth) (oauthToken, error) {
+	secret, err := resolveSecret(auth.Secret, t.secrets)
+	if err != nil {
+		return oauthToken{}, err
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(auth.Scopes) > 0 {
+		form.Set("scope", strings.Join(auth.Scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, auth.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthToken{}, fmt.Errorf("create oauth2 token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(auth.ClientID), url.QueryEscape(secret))
+
+	requestedAt := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return oauthToken{}, fmt.Errorf("request oauth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return oauthToken{}, fmt.Errorf("request oauth2 token: %w", &ports.ProviderStatusError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: retryAfter(resp.Header.Get("Retry-After"), requestedAt),
+		})
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxOAuthTokenResponseBytes)).Decode(&body); err != nil {
+		return oauthToken{}, fmt.Errorf("decode oauth2 token: %w", err)
+	}
+	if body.AccessToken == "" {
+		return oauthToken{}, fmt.Errorf("decode oauth2 token: no access_token")
+	}
+	if body.TokenType != "" && !strings.EqualFold(body.TokenType, "bearer") {
+		return oauthToken{}, fmt.Errorf("decode oauth2 token: unsupported token type %q", body.TokenType)
+	}
+
+	token := oauthToken{accessToken: body.AccessToken}
+	if body.ExpiresIn > 0 {
+		token.expiresAt = requestedAt.Add(time.Duration(body.ExpiresIn)*time.Second - oauthTokenExpiryMargin)
+	}
+	return token, nil
+}
@@ -0,0 +1,189 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchFeed_Auth(t *testing.T) {
+	t.Setenv("FEED_SECRET_TEST_SECRET", "s3cr3t")
+	secretsDir := t.TempDir()
+	secretFile := filepath.Join(secretsDir, "secret")
+	assert.NoError(t, os.WriteFile(secretFile, []byte("from-file\n"), 0o600))
+	outsideFile := filepath.Join(t.TempDir(), "outside")
+	assert.NoError(t, os.WriteFile(outsideFile, []byte("server-secret\n"), 0o600))
+	assert.NoError(t, os.Symlink(outsideFile, filepath.Join(secretsDir, "link")))
+
+	var last *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		last = r
+		w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+	}))
+	defer server.Close()
+
+	fetch := func(auth entity.FeedAuth) error {
+		provider := entity.Provider{BaseURL: server.URL + "?lang=en", Auth: auth}
+		_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{Dir: secretsDir}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+		return err
+	}
+
+	t.Run("API Key Header", func(t *testing.T) {
+		assert.NoError(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_TEST_SECRET"}))
+		assert.Equal(t, "s3cr3t", last.Header.Get("X-API-Key"))
+	})
+
+	t.Run("API Key Query Parameter", func(t *testing.T) {
+		assert.NoError(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_TEST_SECRET", QueryParam: "api_key"}))
+		assert.Equal(t, "s3cr3t", last.URL.Query().Get("api_key"))
+		assert.Equal(t, "en", last.URL.Query().Get("lang"))
+		assert.Empty(t, last.Header.Get("X-API-Key"))
+	})
+
+	t.Run("Bearer Token From A File", func(t *testing.T) {
+		assert.NoError(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "file:" + secretFile}))
+		assert.Equal(t, "Bearer from-file", last.Header.Get("Authorization"))
+	})
+
+	t.Run("Basic Auth", func(t *testing.T) {
+		assert.NoError(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthBasic, Username: "feeds", Secret: "env:FEED_SECRET_TEST_SECRET"}))
+		username, password, ok := last.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "feeds", username)
+		assert.Equal(t, "s3cr3t", password)
+	})
+
+	t.Run("Custom Headers And Query Parameters", func(t *testing.T) {
+		assert.NoError(t, fetch(entity.FeedAuth{
+			Headers: map[string]string{"Accept": "application/json", "X-Partner-Token": "env:FEED_SECRET_TEST_SECRET"},
+			Query:   map[string]string{"region": "eu"},
+		}))
+		assert.Equal(t, "application/json", last.Header.Get("Accept"))
+		assert.Equal(t, "s3cr3t", last.Header.Get("X-Partner-Token"))
+		assert.Equal(t, "eu", last.URL.Query().Get("region"))
+	})
+
+	t.Run("Unset Secret Fails Before The Request", func(t *testing.T) {
+		last = nil
+		err := fetch(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:FEED_SECRET_TEST_MISSING"})
+		assert.EqualError(t, err, "page 1: secret env:FEED_SECRET_TEST_MISSING is not set")
+		assert.Nil(t, last)
+	})
+
+	t.Run("Secrets Outside The Prefix Or Directory Are Refused", func(t *testing.T) {
+		t.Setenv("ADMIN_API_KEY", "admin")
+		last = nil
+		assert.ErrorContains(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:ADMIN_API_KEY"}), "must name an environment variable starting with FEED_SECRET_")
+		assert.ErrorContains(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "file:" + outsideFile}), "must be a file in "+secretsDir)
+		assert.ErrorContains(t, fetch(entity.FeedAuth{Headers: map[string]string{"X-Token": "file:" + secretsDir + "/../outside"}}), "must be a file in "+secretsDir)
+		assert.ErrorContains(t, fetch(entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "file:" + filepath.Join(secretsDir, "link")}), "links outside "+secretsDir)
+		assert.Nil(t, last)
+	})
+}
+
+func TestFetchFeed_TransportErrorsHideSecrets(t *testing.T) {
+	t.Setenv("FEED_SECRET_TEST_SECRET", "s3cr3t")
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	server.Close()
+
+	provider := entity.Provider{
+		BaseURL: server.URL + "/feed?lang=en",
+		Auth: entity.FeedAuth{
+			Kind:       entity.FeedAuthAPIKey,
+			Secret:     "env:FEED_SECRET_TEST_SECRET",
+			QueryParam: "api_key",
+			Query:      map[string]string{"token": "env:FEED_SECRET_TEST_SECRET"},
+		},
+	}
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+	assert.ErrorContains(t, err, server.URL+"/feed")
+	assert.NotContains(t, err.Error(), "s3cr3t")
+}
+
+func TestFetchFeed_AuthStaysOnTheProviderHost(t *testing.T) {
+	t.Setenv("FEED_SECRET_TEST_SECRET", "s3cr3t")
+
+	var nextAuth string
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"contents": [{"id": "b"}]}`))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cr3t", r.Header.Get("Authorization"))
+		w.Header().Set("Link", fmt.Sprintf(`<%s/page2>; rel="next"`, other.URL))
+		w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationLink},
+		Auth:       entity.FeedAuth{Kind: entity.FeedAuthBearer, Secret: "env:FEED_SECRET_TEST_SECRET"},
+	}
+	var items []ports.ProviderContentItem
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, itemIDs(items))
+	assert.Empty(t, nextAuth)
+}
+
+func TestFetchFeed_OAuth2ClientCredentials(t *testing.T) {
+	t.Setenv("FEED_SECRET_TEST_CLIENT_SECRET", "client-secret")
+
+	issued := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, _ := r.BasicAuth()
+		assert.Equal(t, "search", clientID)
+		assert.Equal(t, "client-secret", secret)
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Equal(t, "feeds:read", r.FormValue("scope"))
+
+		issued++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`, issued)
+	}))
+	defer tokenServer.Close()
+
+	revoked := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if revoked[r.Header.Get("Authorization")] {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`<feed><items><item><id>a</id></item></items></feed>`))
+	}))
+	defer server.Close()
+
+	client := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
+	provider := entity.Provider{
+		BaseURL: server.URL,
+		Auth: entity.FeedAuth{
+			Kind:     entity.FeedAuthOAuth2,
+			ClientID: "search",
+			TokenURL: tokenServer.URL,
+			Scopes:   []string{"feeds:read"},
+			Secret:   "env:FEED_SECRET_TEST_CLIENT_SECRET",
+		},
+	}
+	fetch := func() error {
+		_, err := client.FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+		return err
+	}
+
+	assert.NoError(t, fetch())
+	assert.NoError(t, fetch())
+	assert.Equal(t, 1, issued, "the token is reused until it expires")
+
+	revoked["Bearer token-1"] = true
+	assert.NoError(t, fetch())
+	assert.Equal(t, 2, issued, "a rejected token is replaced")
+}
@@ -0,0 +1,512 @@
+package providers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+// compiledMapping is a validated entity.FeedMapping with its paths parsed.
+type compiledMapping struct {
+	items  []string
+	fields map[string]compiledField
+}
+
+type compiledField struct {
+	mapping entity.FieldMapping
+	path    service.FieldPath
+	// optional marks a CSV column read by default rather than named by the
+	// mapping; the header may lack it.
+	optional bool
+}
+
+func compileMapping(format string, mapping entity.FeedMapping) (*compiledMapping, error) {
+	if err := service.ValidateFeedMapping(format, mapping); err != nil {
+		return nil, err
+	}
+	var items []string
+	if format != entity.ProviderFormatCSV {
+		var err error
+		if items, err = service.ParseItemsPath(format, mapping.Items); err != nil {
+			return nil, err
+		}
+	}
+
+	compiled := &compiledMapping{items: items, fields: make(map[string]compiledField)}
+	for _, field := range mapping.Fields() {
+		optional := false
+		if format == entity.ProviderFormatCSV && field.Mapping.Path == "" {
+			field.Mapping.Path = field.Name
+			optional = field.Name != "id" && field.Name != "title"
+		}
+
+		var path service.FieldPath
+		if field.Mapping.Path != "" {
+			var err error
+			if path, err = service.ParseFieldPath(format, field.Mapping.Path); err != nil {
+				return nil, fmt.Errorf("%s mapping: %w", field.Name, err)
+			}
+		}
+		compiled.fields[field.Name] = compiledField{mapping: field.Mapping, path: path, optional: optional}
+	}
+	return compiled, nil
+}
+
+// mapItem builds a content item from the values lookup selects for each
+// field path.
+func (m *compiledMapping) mapItem(lookup func(service.FieldPath) []string) (ports.ProviderContentItem, error) {
+	r := fieldReader{mapping: m, lookup: lookup}
+	item := ports.ProviderContentItem{
+		ProviderContentID: r.text("id"),
+		Title:             r.text("title"),
+		ContentType:       r.text("type"),
+		Views:             r.number("views"),
+		Likes:             r.number("likes"),
+		DurationSec:       int32(r.number("duration")),
+		ReadingTime:       int32(r.number("reading_time")),
+		Reactions:         r.number("reactions"),
+		Comments:          r.number("comments"),
+		PublishedAt:       r.date("published_at"),
+		Tags:              r.values("tags"),
+	}
+	if r.err != nil {
+		return ports.ProviderContentItem{}, r.err
+	}
+	if item.ProviderContentID == "" {
+		return ports.ProviderContentItem{}, fmt.Errorf("id is empty")
+	}
+	return item, nil
+}
+
+// fieldReader reads mapped fields, keeping the first error.
+type fieldReader struct {
+	mapping *compiledMapping
+	lookup  func(service.FieldPath) []string
+	err     error
+}
+
+func (r *fieldReader) values(name string) []string {
+	if r.err != nil {
+		return nil
+	}
+	field := r.mapping.fields[name]
+	var values []string
+	if field.mapping.Path != "" {
+		values = r.lookup(field.path)
+	}
+	if len(values) == 0 && field.mapping.Default != "" {
+		values = []string{field.mapping.Default}
+	}
+	values, err := service.ApplyTransforms(values, field.mapping.Transforms)
+	if err != nil {
+		r.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return values
+}
+
+func (r *fieldReader) text(name string) string {
+	values := r.values(name)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (r *fieldReader) number(name string) int64 {
+	value := strings.TrimSpace(r.text(name))
+	if value == "" || r.err != nil {
+		return 0
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.err = fmt.Errorf("%s: %q is not a number", name, value)
+		return 0
+	}
+	return int64(math.Round(number))
+}
+
+func (r *fieldReader) date(name string) time.Time {
+	value := r.text(name)
+	if value == "" || r.err != nil {
+		return time.Time{}
+	}
+	date, err := service.ParseMappedDate(value, r.mapping.fields[name].mapping.Format)
+	if err != nil {
+		r.err = fmt.Errorf("%s: %w", name, err)
+		return time.Time{}
+	}
+	return date
+}
+
+// decodeMappedJSONPage streams the array the mapping's items path selects,
+// skipping the rest of the document token by token.
+func decodeMappedJSONPage(mapping *compiledMapping) pageDecoder {
+	return func(body io.Reader, cursorField string, yield func(ports.ProviderContentItem) bool) (string, error) {
+		walker := &mappedJSONWalker{
+			decoder:     json.NewDecoder(body),
+			mapping:     mapping,
+			cursorField: cursorField,
+			yield:       yield,
+		}
+		if _, err := walker.walk(mapping.items, true); err != nil {
+			return "", err
+		}
+		return walker.cursor, nil
+	}
+}
+
+type mappedJSONWalker struct {
+	decoder     *json.Decoder
+	mapping     *compiledMapping
+	cursorField string
+	yield       func(ports.ProviderContentItem) bool
+	cursor      string
+	position    int
+}
+
+// walk descends along names to the items array and reports whether yield
+// asked for more. A cursor is only read from the top-level object.
+func (w *mappedJSONWalker) walk(names []string, top bool) (bool, error) {
+	token, err := w.decoder.Token()
+	if err != nil {
+		return false, fmt.Errorf("decode response: %w", err)
+	}
+	if len(names) == 0 {
+		return w.items(token)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return true, skipJSON(w.decoder, token)
+	}
+
+	for w.decoder.More() {
+		token, err := w.decoder.Token()
+		if err != nil {
+			return false, fmt.Errorf("decode response: %w", err)
+		}
+		key, _ := token.(string)
+
+		switch {
+		case key == names[0]:
+			more, err := w.walk(names[1:], false)
+			if err != nil || !more {
+				return more, err
+			}
+		case top && w.cursorField != "" && key == w.cursorField:
+			var raw json.RawMessage
+			if err := w.decoder.Decode(&raw); err != nil {
+				return false, fmt.Errorf("decode response: %w", err)
+			}
+			w.cursor = jsonCursor(raw)
+		default:
+			value, err := w.decoder.Token()
+			if err != nil {
+				return false, fmt.Errorf("decode response: %w", err)
+			}
+			if err := skipJSON(w.decoder, value); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if err := expectJSONDelim(w.decoder, '}'); err != nil {
+		return false, fmt.Errorf("decode response: %w", err)
+	}
+	return true, nil
+}
+
+func (w *mappedJSONWalker) items(first json.Token) (bool, error) {
+	if first == nil {
+		return true, nil
+	}
+	if delim, ok := first.(json.Delim); !ok || delim != '[' {
+		return false, fmt.Errorf("decode contents: items path selects %v, not an array", first)
+	}
+
+	for w.decoder.More() {
+		var rawItem json.RawMessage
+		if err := w.decoder.Decode(&rawItem); err != nil {
+			return false, fmt.Errorf("decode contents: %w", err)
+		}
+		w.position++
+
+		var value any
+		decoder := json.NewDecoder(bytes.NewReader(rawItem))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			return false, fmt.Errorf("decode item: %w", err)
+		}
+		item, err := w.mapping.mapItem(func(path service.FieldPath) []string {
+			return lookupJSON(value, path)
+		})
+		if err != nil {
+			return false, fmt.Errorf("map item %d: %w", w.position, err)
+		}
+		item.RawPayload = rawItem
+
+		if !w.yield(item) {
+			return false, nil
+		}
+	}
+
+	if err := expectJSONDelim(w.decoder, ']'); err != nil {
+		return false, fmt.Errorf("decode contents: %w", err)
+	}
+	return true, nil
+}
+
+// skipJSON skips the rest of a value whose first token was read.
+func skipJSON(decoder *json.Decoder, first json.Token) error {
+	depth := 0
+	if delim, ok := first.(json.Delim); ok && (delim == '{' || delim == '[') {
+		depth = 1
+	}
+	for depth > 0 {
+		token, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+		if delim, ok := token.(json.Delim); ok {
+			if delim == '{' || delim == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+	}
+	return nil
+}
+
+// lookupJSON returns the scalar values path selects in value; selected
+// arrays are flattened.
+func lookupJSON(value any, path service.FieldPath) []string {
+	current := []any{value}
+	for _, step := range path {
+		var next []any
+		for _, v := range current {
+			switch step.Kind {
+			case service.PathKey:
+				if object, ok := v.(map[string]any); ok {
+					if child, ok := object[step.Name]; ok {
+						next = append(next, child)
+					}
+				}
+			case service.PathIndex:
+				if array, ok := v.([]any); ok && step.Index < len(array) {
+					next = append(next, array[step.Index])
+				}
+			case service.PathEach:
+				switch container := v.(type) {
+				case []any:
+					next = append(next, container...)
+				case map[string]any:
+					for _, key := range slices.Sorted(maps.Keys(container)) {
+						next = append(next, container[key])
+					}
+				}
+			}
+		}
+		current = next
+	}
+
+	var values []string
+	for _, v := range current {
+		values = appendJSONValues(values, v)
+	}
+	return values
+}
+
+func appendJSONValues(values []string, value any) []string {
+	switch v := value.(type) {
+	case nil:
+	case string:
+		values = append(values, v)
+	case json.Number:
+		values = append(values, v.String())
+	case bool:
+		values = append(values, strconv.FormatBool(v))
+	case []any:
+		for _, element := range v {
+			values = appendJSONValues(values, element)
+		}
+	default:
+		encoded, _ := json.Marshal(v)
+		values = append(values, string(encoded))
+	}
+	return values
+}
+
+// xmlNode is a generic XML element, decoded for mapped items.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []xmlNode  `xml:",any"`
+}
+
+// decodeMappedXMLPage decodes the elements the mapping's items path
+// selects one at a time while reading the rest of the document token by
+// token.
+func decodeMappedXMLPage(mapping *compiledMapping) pageDecoder {
+	return func(body io.Reader, cursorField string, yield func(ports.ProviderContentItem) bool) (string, error) {
+		decoder := xml.NewDecoder(body)
+		var cursor string
+		var stack []string
+		position := 0
+
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return "", fmt.Errorf("decode xml: %w", err)
+			}
+
+			switch t := token.(type) {
+			case xml.StartElement:
+				stack = append(stack, t.Name.Local)
+				switch {
+				case matchesXMLPath(stack, mapping.items):
+					var node xmlNode
+					if err := decoder.DecodeElement(&node, &t); err != nil {
+						return "", fmt.Errorf("decode xml: %w", err)
+					}
+					stack = stack[:len(stack)-1]
+					position++
+
+					item, err := mapping.mapItem(func(path service.FieldPath) []string {
+						return lookupXML(&node, path)
+					})
+					if err != nil {
+						return "", fmt.Errorf("map item %d: %w", position, err)
+					}
+					item.RawPayload, _ = json.Marshal(node.payload())
+
+					if !yield(item) {
+						return cursor, nil
+					}
+				case len(stack) == 2 && cursorField != "" && t.Name.Local == cursorField:
+					var value string
+					if err := decoder.DecodeElement(&value, &t); err != nil {
+						return "", fmt.Errorf("decode xml: %w", err)
+					}
+					stack = stack[:len(stack)-1]
+					cursor = strings.TrimSpace(value)
+				}
+			case xml.EndElement:
+				stack = stack[:len(stack)-1]
+				if len(stack) == 0 {
+					return cursor, nil
+				}
+			}
+		}
+	}
+}
+
+func matchesXMLPath(stack, names []string) bool {
+	if len(stack) != len(names) {
+		return false
+	}
+	for i, name := range names {
+		if !xmlNameMatches(name, stack[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// xmlNameMatches compares a path step to an element's local name; a
+// namespace prefix in the step is ignored.
+func xmlNameMatches(step, local string) bool {
+	if step == "*" {
+		return true
+	}
+	if _, name, ok := strings.Cut(step, ":"); ok {
+		step = name
+	}
+	return step == local
+}
+
+// lookupXML returns the trimmed texts or attribute values path selects
+// under node. Positions apply to all elements the previous step selected.
+func lookupXML(node *xmlNode, path service.FieldPath) []string {
+	nodes := []*xmlNode{node}
+	for _, step := range path {
+		switch step.Kind {
+		case service.PathKey:
+			var next []*xmlNode
+			for _, n := range nodes {
+				for i := range n.Children {
+					if xmlNameMatches(step.Name, n.Children[i].XMLName.Local) {
+						next = append(next, &n.Children[i])
+					}
+				}
+			}
+			nodes = next
+		case service.PathIndex:
+			if step.Index < len(nodes) {
+				nodes = nodes[step.Index : step.Index+1]
+			} else {
+				nodes = nil
+			}
+		case service.PathAttribute:
+			var values []string
+			for _, n := range nodes {
+				for _, attr := range n.Attrs {
+					if xmlNameMatches(step.Name, attr.Name.Local) {
+						values = append(values, attr.Value)
+					}
+				}
+			}
+			return values
+		}
+	}
+
+	var values []string
+	for _, n := range nodes {
+		if text := strings.TrimSpace(n.Text); text != "" {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+// payload converts n for the raw payload: a leaf becomes its text, other
+// elements an object of attributes ("@name"), children, as arrays when
+// repeated, and text ("#text").
+func (n *xmlNode) payload() any {
+	text := strings.TrimSpace(n.Text)
+	if len(n.Attrs) == 0 && len(n.Children) == 0 {
+		return text
+	}
+
+	object := make(map[string]any)
+	for _, attr := range n.Attrs {
+		object["@"+attr.Name.Local] = attr.Value
+	}
+	for i := range n.Children {
+		child := &n.Children[i]
+		value := child.payload()
+		switch existing := object[child.XMLName.Local].(type) {
+		case nil:
+			object[child.XMLName.Local] = value
+		case []any:
+			object[child.XMLName.Local] = append(existing, value)
+		default:
+			object[child.XMLName.Local] = []any{existing, value}
+		}
+	}
+	if text != "" {
+		object["#text"] = text
+	}
+	return object
+}
@@ -0,0 +1,150 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchContents_MappedJSONFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("after") {
+		case "":
+			w.Write([]byte(`{
+				"meta": {"total": 3, "notes": [1, {"a": null}]},
+				"data": {"results": [
+					{"uid": 7, "headline": "Go Streams", "kind": "VID", "stats": {"plays": 1200, "hearts": 30},
+					 "length": "01:02:03", "created": 1700000000, "labels": "go, streams"},
+					{"uid": "8", "headline": "Unmapped Kind", "kind": "audio", "stats": {}, "labels": ""}
+				]},
+				"next_page": "p2"
+			}`))
+		case "p2":
+			w.Write([]byte(`{"data": {"results": [{"uid": "9", "headline": "Last", "read_min": 2.5}]}}`))
+		}
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		Format:     entity.ProviderFormatJSON,
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "after", CursorField: "next_page"},
+		Mapping: entity.FeedMapping{
+			Items: "$.data.results[*]",
+			ID:    entity.FieldMapping{Path: "$.uid"},
+			Title: entity.FieldMapping{Path: "$.headline"},
+			Type: entity.FieldMapping{Path: "$.kind", Default: "article", Transforms: []entity.ValueTransform{
+				{Kind: entity.TransformLower},
+				{Kind: entity.TransformMap, Values: map[string]string{"vid": "video"}},
+			}},
+			Views:       entity.FieldMapping{Path: "$.stats.plays"},
+			Likes:       entity.FieldMapping{Path: "$.stats.hearts"},
+			Duration:    entity.FieldMapping{Path: "$.length", Transforms: []entity.ValueTransform{{Kind: entity.TransformDuration}}},
+			ReadingTime: entity.FieldMapping{Path: "$.read_min", Transforms: []entity.ValueTransform{{Kind: entity.TransformScale, Arg: "2"}}},
+			PublishedAt: entity.FieldMapping{Path: "$.created", Format: "unix"},
+			Tags:        entity.FieldMapping{Path: "$.labels", Transforms: []entity.ValueTransform{{Kind: entity.TransformSplit, Arg: ","}}},
+		},
+	}
+
+	var items []ports.ProviderContentItem
+	result, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Pages)
+	assert.Equal(t, []string{"7", "8", "9"}, itemIDs(items))
+
+	first := items[0]
+	assert.Equal(t, "Go Streams", first.Title)
+	assert.Equal(t, "video", first.ContentType)
+	assert.Equal(t, int64(1200), first.Views)
+	assert.Equal(t, int64(30), first.Likes)
+	assert.Equal(t, int32(3723), first.DurationSec)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), first.PublishedAt)
+	assert.Equal(t, []string{"go", "streams"}, first.Tags)
+	assert.JSONEq(t, `{"uid": 7, "headline": "Go Streams", "kind": "VID", "stats": {"plays": 1200, "hearts": 30},
+		"length": "01:02:03", "created": 1700000000, "labels": "go, streams"}`, string(first.RawPayload))
+
+	assert.Equal(t, "audio", items[1].ContentType)
+	assert.Empty(t, items[1].Tags)
+	assert.Equal(t, "article", items[2].ContentType)
+	assert.Equal(t, int32(5), items[2].ReadingTime)
+}
+
+func TestFetchContents_MappedJSONItemErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"items": [{"id": "a", "title": "A", "views": "many"}]}`))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		Format:  entity.ProviderFormatJSON,
+		BaseURL: server.URL,
+		Mapping: entity.FeedMapping{
+			Items: "$.items",
+			ID:    entity.FieldMapping{Path: "$.id"},
+			Title: entity.FieldMapping{Path: "$.title"},
+			Views: entity.FieldMapping{Path: "$.views"},
+		},
+	}
+
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+	assert.ErrorContains(t, err, `map item 1: views: "many" is not a number`)
+}
+
+func TestFetchContents_MappedXMLFeed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0"?>
+<catalog xmlns:m="urn:media">
+	<info><count>2</count></info>
+	<entries>
+		<entry ref="x1">
+			<name> Intro to XML </name>
+			<m:kind>article</m:kind>
+			<published>2024-03-01 10:00</published>
+			<topics><topic>xml</topic><topic>feeds</topic></topics>
+			<metric name="views">15</metric>
+			<metric name="likes">4</metric>
+		</entry>
+		<entry ref="x2"><name>Second</name></entry>
+	</entries>
+</catalog>`))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		Format:  entity.ProviderFormatXML,
+		BaseURL: server.URL,
+		Mapping: entity.FeedMapping{
+			Items:       "/catalog/entries/entry",
+			ID:          entity.FieldMapping{Path: "@ref"},
+			Title:       entity.FieldMapping{Path: "name", Transforms: []entity.ValueTransform{{Kind: entity.TransformTrim}}},
+			Type:        entity.FieldMapping{Path: "kind/text()", Default: "video"},
+			Views:       entity.FieldMapping{Path: "metric[1]"},
+			Likes:       entity.FieldMapping{Path: "metric[2]"},
+			PublishedAt: entity.FieldMapping{Path: "published", Format: "2006-01-02 15:04"},
+			Tags:        entity.FieldMapping{Path: "topics/topic"},
+		},
+	}
+
+	var items []ports.ProviderContentItem
+	_, err := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x1", "x2"}, itemIDs(items))
+
+	first := items[0]
+	assert.Equal(t, "Intro to XML", first.Title)
+	assert.Equal(t, "article", first.ContentType)
+	assert.Equal(t, int64(15), first.Views)
+	assert.Equal(t, int64(4), first.Likes)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), first.PublishedAt)
+	assert.Equal(t, []string{"xml", "feeds"}, first.Tags)
+	assert.NotEmpty(t, first.RawPayload)
+
+	assert.Equal(t, "video", items[1].ContentType)
+	assert.True(t, items[1].PublishedAt.IsZero())
+}
@@ -0,0 +1,220 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// pageRetryBackoff is the wait before the first retry of a page; each
+// further retry waits one more step.
+var pageRetryBackoff = 500 * time.Millisecond
+
+// maxPageRetryAfter is the longest Retry-After a page retry waits out. A
+// provider asking for longer fails the page, leaving the wait to the fetch
+// retries, which span syncs.
+const maxPageRetryAfter = 10 * time.Second
+
+// Feed requests have no overall deadline: a page's body stays open while
+// its items are written, which for a large feed takes far longer than any
+// fixed limit. The provider's sync timeout bounds the fetch as a whole, and
+// these bound each stage of a request.
+var (
+	// feedDialTimeout bounds connecting to a provider, TLS handshake
+	// included.
+	feedDialTimeout = 10 * time.Second
+	// feedResponseHeaderTimeout bounds the wait for a response once the
+	// request is sent.
+	feedResponseHeaderTimeout = 30 * time.Second
+	// feedIdleReadTimeout bounds a single read of a response body, so a
+	// provider that stops sending fails the page. Time spent writing items
+	// between reads does not count.
+	feedIdleReadTimeout = 30 * time.Second
+)
+
+// oauthTokenTimeout bounds a whole token request, whose response is small.
+const oauthTokenTimeout = 10 * time.Second
+
+// feedHTTPClient sends the feed requests of a provider client, with each
+// provider's auth, headers and query parameters.
+type feedHTTPClient struct {
+	http            *http.Client
+	tokens          *oauthTokens
+	secrets         entity.FeedSecretsConfig
+	idleReadTimeout time.Duration
+}
+
+func newFeedHTTPClient(secrets entity.FeedSecretsConfig) *feedHTTPClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   feedDialTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = feedDialTimeout
+	transport.ResponseHeaderTimeout = feedResponseHeaderTimeout
+
+	return &feedHTTPClient{
+		http: &http.Client{Transport: transport},
+		tokens: newOAuthTokens(&http.Client{
+			Transport: transport,
+			Timeout:   oauthTokenTimeout,
+		}, secrets),
+		secrets:         secrets,
+		idleReadTimeout: feedIdleReadTimeout,
+	}
+}
+
+// newFeedRequest builds the GET for a provider feed, made conditional by
+// the validators of the previous fetch when there are any.
+func newFeedRequest(ctx context.Context, url string, validators entity.FeedValidators) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Set("If-Modified-Since", validators.LastModified)
+	}
+	return req, nil
+}
+
+func responseValidators(resp *http.Response) entity.FeedValidators {
+	return entity.FeedValidators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+}
+
+// getPage requests one page of the provider's feed, retrying transport
+// errors, 429 and 5xx responses up to the provider's page retries. The
+// response is either 200, or 304 when validators were sent.
+func getPage(ctx context.Context, client *feedHTTPClient, provider entity.Provider, url string, validators entity.FeedValidators) (*http.Response, error) {
+	retries := provider.Pagination.GetPageRetries()
+	for attempt := 0; ; attempt++ {
+		resp, retryable, err := requestPage(ctx, client, provider, url, validators)
+		if err == nil {
+			return resp, nil
+		}
+		if !retryable || attempt >= retries {
+			return nil, err
+		}
+
+		wait := pageRetryBackoff * time.Duration(attempt+1)
+		var statusErr *ports.ProviderStatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > wait {
+			if statusErr.RetryAfter > maxPageRetryAfter {
+				return nil, err
+			}
+			wait = statusErr.RetryAfter
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(wait):
+		}
+	}
+}
+
+func requestPage(ctx context.Context, client *feedHTTPClient, provider entity.Provider, url string, validators entity.FeedValidators) (*http.Response, bool, error) {
+	// The request has its own context so a stalled body read can be
+	// aborted.
+	reqCtx, cancel := context.WithCancel(ctx)
+	req, err := newFeedRequest(reqCtx, url, validators)
+	if err != nil {
+		cancel()
+		return nil, false, err
+	}
+	if err := client.authorize(ctx, req, provider); err != nil {
+		cancel()
+		return nil, false, err
+	}
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		cancel()
+		return nil, ctx.Err() == nil, fmt.Errorf("fetch data: %w", redactURLError(err))
+	}
+	resp.Body = newIdleReadBody(resp.Body, client.idleReadTimeout, cancel)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return resp, false, nil
+	case resp.StatusCode == http.StatusNotModified && !validators.IsZero():
+		return resp, false, nil
+	}
+
+	resp.Body.Close()
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	// A rejected OAuth2 token may have been revoked before it expired; the
+	// retry requests a new one.
+	if resp.StatusCode == http.StatusUnauthorized && provider.Auth.Kind == entity.FeedAuthOAuth2 {
+		client.tokens.invalidate(provider.Auth)
+		retryable = true
+	}
+	return nil, retryable, &ports.ProviderStatusError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// idleReadBody fails a response body read that gets no data for timeout,
+// by cancelling the request. Closing the body releases the request.
+type idleReadBody struct {
+	body    io.ReadCloser
+	timeout time.Duration
+	cancel  context.CancelFunc
+	stalled atomic.Bool
+}
+
+func newIdleReadBody(body io.ReadCloser, timeout time.Duration, cancel context.CancelFunc) *idleReadBody {
+	return &idleReadBody{body: body, timeout: timeout, cancel: cancel}
+}
+
+func (b *idleReadBody) Read(p []byte) (int, error) {
+	timer := time.AfterFunc(b.timeout, func() {
+		b.stalled.Store(true)
+		b.cancel()
+	})
+	n, err := b.body.Read(p)
+	timer.Stop()
+	if err != nil && b.stalled.Load() {
+		return n, fmt.Errorf("read feed: no data for %s", b.timeout)
+	}
+	return n, err
+}
+
+func (b *idleReadBody) Close() error {
+	err := b.body.Close()
+	b.cancel()
+	return err
+}
+
+// retryAfter reads a Retry-After header, given in seconds or as an HTTP
+// date. It is zero when the header is missing, malformed or in the past.
+func retryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
@@ -0,0 +1,86 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 120*time.Second, retryAfter("120", now))
+	assert.Equal(t, 90*time.Second, retryAfter("Fri, 01 Mar 2024 12:01:30 GMT", now))
+	assert.Zero(t, retryAfter("Fri, 01 Mar 2024 11:00:00 GMT", now))
+	assert.Zero(t, retryAfter("-5", now))
+	assert.Zero(t, retryAfter("soon", now))
+	assert.Zero(t, retryAfter("", now))
+}
+
+func TestGetPage_StatusErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{BaseURL: server.URL, Pagination: entity.FeedPagination{PageRetries: 2}}
+	_, err := getPage(context.Background(), newFeedHTTPClient(entity.FeedSecretsConfig{}), provider, server.URL, entity.FeedValidators{})
+	assert.Equal(t, &ports.ProviderStatusError{StatusCode: http.StatusServiceUnavailable, RetryAfter: time.Hour}, err)
+	// A Retry-After longer than a page retry waits fails the page at once.
+	assert.Equal(t, 1, calls)
+}
+
+func TestFetchFeed_SlowHandlerFinishes(t *testing.T) {
+	defer func(timeout time.Duration) { feedIdleReadTimeout = timeout }(feedIdleReadTimeout)
+	feedIdleReadTimeout = 50 * time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contents": [{"id": "a"}, {"id": "b"}]}`))
+	}))
+	defer server.Close()
+
+	// Writing the page takes longer than the idle read timeout, which only
+	// counts time spent waiting on the provider.
+	var seen []string
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, func(_ context.Context, items ports.ContentItems) error {
+		for item, err := range items {
+			assert.NoError(t, err)
+			time.Sleep(100 * time.Millisecond)
+			seen = append(seen, item.ProviderContentID)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, seen)
+}
+
+func TestFetchFeed_StalledBodyFails(t *testing.T) {
+	defer func(timeout time.Duration) { feedIdleReadTimeout = timeout }(feedIdleReadTimeout)
+	feedIdleReadTimeout = 50 * time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contents": [{"id": "a"}, `))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	var items []ports.ProviderContentItem
+	started := time.Now()
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, collectItems(&items))
+	assert.ErrorContains(t, err, "read feed: no data for 50ms")
+	assert.Less(t, time.Since(started), time.Second)
+	assert.Equal(t, []string{"a"}, itemIDs(items))
+}
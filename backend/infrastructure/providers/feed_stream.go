@@ -0,0 +1,91 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// pageDecoder streams the items of one feed page to yield in document
+// order and returns the page's cursor, read from cursorField when set. It
+// stops early, without error, once yield returns false.
+type pageDecoder func(body io.Reader, cursorField string, yield func(ports.ProviderContentItem) bool) (cursor string, err error)
+
+// feedLimits bound what a single fetch reads from a provider.
+type feedLimits struct {
+	maxBodyBytes int64
+	maxItems     int
+}
+
+func newFeedLimits(config entity.SyncConfig) feedLimits {
+	return feedLimits{
+		maxBodyBytes: config.GetMaxFeedBodyBytes(),
+		maxItems:     config.GetMaxFeedItems(),
+	}
+}
+
+// pageStream decodes a page response lazily, as its items are ranged over.
+type pageStream struct {
+	body         io.Reader
+	maxBodyBytes int64
+	cursorField  string
+	decode       pageDecoder
+	// remaining is the number of items the fetch may still read.
+	remaining int
+
+	started bool
+	count   int
+	cursor  string
+	err     error
+	// limited reports that the page had more items than remaining.
+	limited bool
+}
+
+func newPageStream(body io.ReadCloser, limits feedLimits, remaining int, cursorField string, decode pageDecoder) *pageStream {
+	return &pageStream{
+		body:         http.MaxBytesReader(nil, body, limits.maxBodyBytes),
+		maxBodyBytes: limits.maxBodyBytes,
+		cursorField:  cursorField,
+		decode:       decode,
+		remaining:    remaining,
+	}
+}
+
+// items is the page's ports.ContentItems. Decoding goes on after the
+// consumer stops ranging, so the page is always counted in full.
+func (s *pageStream) items(yield func(ports.ProviderContentItem, error) bool) {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	consuming := true
+	s.cursor, s.err = s.decode(s.body, s.cursorField, func(item ports.ProviderContentItem) bool {
+		if s.count == s.remaining {
+			s.limited = true
+			return false
+		}
+		s.count++
+		if consuming {
+			consuming = yield(item, nil)
+		}
+		return true
+	})
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(s.err, &tooLarge) {
+		s.err = fmt.Errorf("response body exceeds %d bytes", s.maxBodyBytes)
+	}
+	if s.err != nil && consuming {
+		yield(ports.ProviderContentItem{}, s.err)
+	}
+}
+
+// drain decodes what the consumer left unread.
+func (s *pageStream) drain() {
+	s.items(func(ports.ProviderContentItem, error) bool { return false })
+}
@@ -0,0 +1,105 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchFeed_StreamsItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contents": [{"id": "a"}, {"id": "b"}, {"id": "c"}]}`))
+	}))
+	defer server.Close()
+
+	var seen []string
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, func(_ context.Context, items ports.ContentItems) error {
+		for item, err := range items {
+			assert.NoError(t, err)
+			seen = append(seen, item.ProviderContentID)
+			if len(seen) == 2 {
+				break
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, seen)
+}
+
+func TestFetchFeed_UnreadItemsAreCounted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`<feed><items><item><id>a</id></item><item><id>b</id></item></items><next>c2</next></feed>`))
+		case "c2":
+			w.Write([]byte(`<feed><items><item><id>c</id></item></items></feed>`))
+		}
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "cursor", CursorField: "next"},
+	}
+
+	result, err := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, func(context.Context, ports.ContentItems) error {
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Pages)
+	assert.Equal(t, 3, result.ItemCount)
+}
+
+func TestFetchFeed_DecodeErrorAfterItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contents": [{"id": "a"}, {"id": `))
+	}))
+	defer server.Close()
+
+	var items []ports.ProviderContentItem
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, collectItems(&items))
+	assert.ErrorContains(t, err, "page 1: decode contents")
+	assert.Equal(t, []string{"a"}, itemIDs(items))
+}
+
+func TestFetchFeed_Limits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"contents": [{"id": "a"}, {"id": "b"}, {"id": "c"}]}`))
+	}))
+	defer server.Close()
+	provider := entity.Provider{BaseURL: server.URL}
+
+	t.Run("Item Limit Truncates", func(t *testing.T) {
+		var items []ports.ProviderContentItem
+		result, err := NewJsonProviderClient(entity.SyncConfig{MaxFeedItems: 2}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, itemIDs(items))
+		assert.Equal(t, 2, result.ItemCount)
+		assert.True(t, result.Truncated)
+	})
+
+	t.Run("Exact Item Limit Is Not Truncated", func(t *testing.T) {
+		result, err := NewJsonProviderClient(entity.SyncConfig{MaxFeedItems: 3}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+		assert.NoError(t, err)
+		assert.False(t, result.Truncated)
+	})
+
+	t.Run("Body Limit Fails The Fetch", func(t *testing.T) {
+		var items []ports.ProviderContentItem
+		_, err := NewJsonProviderClient(entity.SyncConfig{MaxFeedBodyBytes: 30}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+		assert.EqualError(t, err, "page 1: response body exceeds 30 bytes")
+		assert.Equal(t, []string{"a"}, itemIDs(items))
+	})
+}
+
+func TestDecodeXMLPage_RejectsOtherRoots(t *testing.T) {
+	_, err := decodeXMLPage(strings.NewReader(`<rss><items/></rss>`), "", func(ports.ProviderContentItem) bool { return true })
+	assert.EqualError(t, err, "decode xml: expected element type <feed> but have <rss>")
+}
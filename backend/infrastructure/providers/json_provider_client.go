@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"io"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -12,17 +12,14 @@ import (
 )
 
 type JsonProviderClient struct {
-	client *http.Client
-}
-
-type jsonResponse struct {
-	Contents []jsonItem `json:"contents"`
+	client *feedHTTPClient
+	limits feedLimits
 }
 
 type jsonItem struct {
-	ID      string   `json:"id"`
-	Title   string   `json:"title"`
-	Type    string   `json:"type"`
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Type    string `json:"type"`
 	Metrics struct {
 		Views       int64  `json:"views"`
 		Likes       int64  `json:"likes"`
@@ -39,52 +36,104 @@ func parseDurationString(durationStr string) int32 {
 	if durationStr == "" {
 		return 0
 	}
-	
+
 	var duration int32
 	fmt.Sscanf(durationStr, "%d", &duration)
 	return duration
 }
 
-func NewJsonProviderClient() ports.ProviderClient {
+func NewJsonProviderClient(config entity.SyncConfig, secrets entity.FeedSecretsConfig) ports.ProviderClient {
 	return &JsonProviderClient{
-		client: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		client: newFeedHTTPClient(secrets),
+		limits: newFeedLimits(config),
 	}
 }
 
-func (p *JsonProviderClient) FetchContents(ctx context.Context, provider entity.Provider) ([]ports.ProviderContentItem, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.BaseURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
+// FetchContents reads the feed in the built-in schema below unless the
+// provider carries a feed mapping.
+func (p *JsonProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	decode := pageDecoder(decodeJSONPage)
+	if !provider.Mapping.IsZero() {
+		mapping, err := compileMapping(entity.ProviderFormatJSON, provider.Mapping)
+		if err != nil {
+			return nil, fmt.Errorf("compile feed mapping: %w", err)
+		}
+		decode = decodeMappedJSONPage(mapping)
 	}
+	return fetchFeed(ctx, p.client, p.limits, provider, validators, decode, handle)
+}
 
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("fetch data: %w", err)
+// decodeJSONPage walks the top-level object token by token, so only one
+// item of the "contents" array is held in memory at a time.
+func decodeJSONPage(body io.Reader, cursorField string, yield func(ports.ProviderContentItem) bool) (string, error) {
+	decoder := json.NewDecoder(body)
+	if err := expectJSONDelim(decoder, '{'); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	var cursor string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("decode response: %w", err)
+		}
+		key, _ := token.(string)
+
+		switch {
+		case key == "contents":
+			more, err := decodeJSONContents(decoder, yield)
+			if err != nil {
+				return "", err
+			}
+			if !more {
+				return cursor, nil
+			}
+		case cursorField != "" && key == cursorField:
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				return "", fmt.Errorf("decode response: %w", err)
+			}
+			cursor = jsonCursor(raw)
+		default:
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return "", fmt.Errorf("decode response: %w", err)
+			}
+		}
 	}
 
-	rawBody, err := json.Marshal(resp.Body)
-	if err != nil {
-		rawBody = []byte{}
+	if err := expectJSONDelim(decoder, '}'); err != nil {
+		return "", fmt.Errorf("decode response: %w", err)
 	}
+	return cursor, nil
+}
 
-	var data jsonResponse
-	decoder := json.NewDecoder(resp.Body)
-	if err := decoder.Decode(&data); err != nil {
-		return nil, fmt.Errorf("decode response: %w", err)
+// decodeJSONContents yields the items of the "contents" array and reports
+// whether yield asked for more.
+func decodeJSONContents(decoder *json.Decoder, yield func(ports.ProviderContentItem) bool) (bool, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return false, fmt.Errorf("decode contents: %w", err)
+	}
+	if token == nil {
+		return true, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return false, fmt.Errorf("decode contents: expected an array, got %v", token)
 	}
 
-	items := make([]ports.ProviderContentItem, 0, len(data.Contents))
-	for _, item := range data.Contents {
-		itemPayload, _ := json.Marshal(item)
-		
-		items = append(items, ports.ProviderContentItem{
+	for decoder.More() {
+		var rawItem json.RawMessage
+		if err := decoder.Decode(&rawItem); err != nil {
+			return false, fmt.Errorf("decode contents: %w", err)
+		}
+
+		var item jsonItem
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			return false, fmt.Errorf("decode item: %w", err)
+		}
+
+		more := yield(ports.ProviderContentItem{
 			ProviderContentID: item.ID,
 			Title:             item.Title,
 			ContentType:       item.Type,
@@ -96,11 +145,40 @@ func (p *JsonProviderClient) FetchContents(ctx context.Context, provider entity.
 			Comments:          item.Metrics.Comments,
 			PublishedAt:       item.PublishedAt,
 			Tags:              item.Tags,
-			RawPayload:        itemPayload,
+			RawPayload:        rawItem,
 		})
+		if !more {
+			return false, nil
+		}
 	}
 
-	_ = rawBody
+	if err := expectJSONDelim(decoder, ']'); err != nil {
+		return false, fmt.Errorf("decode contents: %w", err)
+	}
+	return true, nil
+}
 
-	return items, nil
+func expectJSONDelim(decoder *json.Decoder, want json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v, got %v", want, token)
+	}
+	return nil
+}
+
+// jsonCursor reads a cursor sent as a JSON string or number; null or a
+// missing field yields "".
+func jsonCursor(raw json.RawMessage) string {
+	var cursor string
+	if err := json.Unmarshal(raw, &cursor); err == nil {
+		return cursor
+	}
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err == nil {
+		return number.String()
+	}
+	return ""
 }
@@ -2,12 +2,14 @@ package providers
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,17 +40,19 @@ func TestJsonProviderClient_FetchContents(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewJsonProviderClient()
+	client := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
 	provider := entity.Provider{
 		BaseURL: server.URL,
 	}
 
 	ctx := context.Background()
-	items, err := client.FetchContents(ctx, provider)
+	var items []ports.ProviderContentItem
+	result, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, collectItems(&items))
 
 	assert.NoError(t, err)
+	assert.False(t, result.NotModified)
 	assert.Len(t, items, 1)
-	
+
 	item := items[0]
 	assert.Equal(t, "1", item.ProviderContentID)
 	assert.Equal(t, "Test Video", item.Title)
@@ -56,9 +60,13 @@ func TestJsonProviderClient_FetchContents(t *testing.T) {
 	assert.Equal(t, int64(100), item.Views)
 	assert.Equal(t, int32(120), item.DurationSec)
 	assert.Len(t, item.Tags, 2)
-	
+
 	expectedTime, _ := time.Parse(time.RFC3339, "2023-10-25T12:00:00Z")
 	assert.Equal(t, expectedTime, item.PublishedAt)
+
+	// The raw payload keeps the item exactly as the provider sent it
+	assert.Contains(t, string(item.RawPayload), `"duration": "120"`)
+	assert.True(t, json.Valid(item.RawPayload))
 }
 
 func TestJsonProviderClient_FetchContents_Error(t *testing.T) {
@@ -67,14 +75,54 @@ func TestJsonProviderClient_FetchContents_Error(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewJsonProviderClient()
+	client := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
 	provider := entity.Provider{
 		BaseURL: server.URL,
 	}
 
 	ctx := context.Background()
-	items, err := client.FetchContents(ctx, provider)
+	result, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
 
 	assert.Error(t, err)
-	assert.Nil(t, items)
+	assert.Nil(t, result)
+}
+
+func TestJsonProviderClient_FetchContents_Conditional(t *testing.T) {
+	const etag = `"v2"`
+	const lastModified = "Wed, 25 Oct 2023 12:00:00 GMT"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"contents": [{"id": "1", "title": "Test Video", "type": "video"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
+	provider := entity.Provider{BaseURL: server.URL}
+	ctx := context.Background()
+
+	t.Run("Returns Validators Of Fetched Feed", func(t *testing.T) {
+		var items []ports.ProviderContentItem
+		result, err := client.FetchContents(ctx, provider, entity.FeedValidators{ETag: `"v1"`}, collectItems(&items))
+		assert.NoError(t, err)
+		assert.False(t, result.NotModified)
+		assert.Len(t, items, 1)
+		assert.Equal(t, entity.FeedValidators{ETag: etag, LastModified: lastModified}, result.Validators)
+	})
+
+	t.Run("Not Modified", func(t *testing.T) {
+		validators := entity.FeedValidators{ETag: etag, LastModified: lastModified}
+		var items []ports.ProviderContentItem
+		result, err := client.FetchContents(ctx, provider, validators, collectItems(&items))
+		assert.NoError(t, err)
+		assert.True(t, result.NotModified)
+		assert.Empty(t, items)
+		assert.Equal(t, validators, result.Validators)
+	})
 }
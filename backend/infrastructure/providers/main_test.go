@@ -0,0 +1,13 @@
+package providers
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	// Keep retried pages from slowing the suite down.
+	pageRetryBackoff = time.Millisecond
+	os.Exit(m.Run())
+}
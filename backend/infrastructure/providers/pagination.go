@@ -0,0 +1,175 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// pageState locates the next page to request.
+type pageState struct {
+	number int
+	offset int
+	cursor string
+	// next is the URL taken from the previous page's Link header.
+	next string
+}
+
+// fetchFeed requests a provider feed page by page, following the provider's
+// pagination up to its page limit, and streams each page to handle before
+// requesting the next one.
+func fetchFeed(ctx context.Context, client *feedHTTPClient, limits feedLimits, provider entity.Provider, validators entity.FeedValidators, decode pageDecoder, handle ports.PageHandler) (*ports.FetchResult, error) {
+	pagination := provider.Pagination
+	result := &ports.FetchResult{}
+	state := pageState{number: pagination.GetFirstPage()}
+
+	// Validators cover a single response, and a 304 for one page says
+	// nothing about the others, so only unpaginated feeds are requested
+	// conditionally.
+	conditional := pagination.Strategy == entity.PaginationNone
+	if !conditional {
+		validators = entity.FeedValidators{}
+	}
+
+	for {
+		if result.Pages == pagination.GetMaxPages() {
+			result.Truncated = true
+			return result, nil
+		}
+
+		pageURL, err := state.url(provider.BaseURL, pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := getPage(ctx, client, provider, pageURL, validators)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", result.Pages+1, err)
+		}
+		if resp.StatusCode == http.StatusNotModified {
+			resp.Body.Close()
+			return &ports.FetchResult{NotModified: true, Validators: validators}, nil
+		}
+		if conditional {
+			result.Validators = responseValidators(resp)
+		}
+
+		page := newPageStream(resp.Body, limits, limits.maxItems-result.ItemCount, pagination.CursorField, decode)
+		handleErr := handle(ctx, page.items)
+		if handleErr == nil {
+			page.drain()
+		}
+		resp.Body.Close()
+		if page.err != nil {
+			return nil, fmt.Errorf("page %d: %w", result.Pages+1, page.err)
+		}
+		if handleErr != nil {
+			return nil, handleErr
+		}
+		result.Pages++
+		result.ItemCount += page.count
+
+		if page.limited {
+			result.Truncated = true
+			return result, nil
+		}
+		if !state.advance(pagination, page, resp) {
+			return result, nil
+		}
+	}
+}
+
+func (s pageState) url(baseURL string, pagination entity.FeedPagination) (string, error) {
+	if s.next != "" {
+		return s.next, nil
+	}
+	if pagination.Strategy == entity.PaginationNone {
+		return baseURL, nil
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse feed url: %w", err)
+	}
+
+	query := u.Query()
+	if pagination.SizeParam != "" {
+		query.Set(pagination.SizeParam, strconv.Itoa(pagination.PageSize))
+	}
+	switch pagination.Strategy {
+	case entity.PaginationPage:
+		query.Set(pagination.Param, strconv.Itoa(s.number))
+	case entity.PaginationOffset:
+		query.Set(pagination.Param, strconv.Itoa(s.offset))
+	case entity.PaginationCursor:
+		if s.cursor != "" {
+			query.Set(pagination.Param, s.cursor)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// advance moves s past page and reports whether the feed has another page.
+func (s *pageState) advance(pagination entity.FeedPagination, page *pageStream, resp *http.Response) bool {
+	switch pagination.Strategy {
+	case entity.PaginationPage, entity.PaginationOffset:
+		if page.count == 0 || page.count < pagination.PageSize {
+			return false
+		}
+		s.number++
+		s.offset += page.count
+		return true
+	case entity.PaginationCursor:
+		if page.cursor == "" || page.cursor == s.cursor {
+			return false
+		}
+		s.cursor = page.cursor
+		return true
+	case entity.PaginationLink:
+		next := nextLink(resp.Header.Values("Link"))
+		if next == "" {
+			return false
+		}
+		ref, err := resp.Request.URL.Parse(next)
+		if err != nil {
+			return false
+		}
+		s.next = ref.String()
+		return true
+	default:
+		return false
+	}
+}
+
+// nextLink returns the target of the rel="next" link in RFC 8288 Link
+// header values, or "" if there is none.
+func nextLink(values []string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			target, params, ok := strings.Cut(strings.TrimSpace(link), ";")
+			if !ok || !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range strings.Split(params, ";") {
+				name, rel, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(name, "rel") {
+					continue
+				}
+				for _, r := range strings.Fields(strings.Trim(rel, `"`)) {
+					if strings.EqualFold(r, "next") {
+						return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,254 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+// collectItems returns a page handler appending every page's items to items.
+func collectItems(items *[]ports.ProviderContentItem) ports.PageHandler {
+	return func(_ context.Context, page ports.ContentItems) error {
+		for item, err := range page {
+			if err != nil {
+				return err
+			}
+			*items = append(*items, item)
+		}
+		return nil
+	}
+}
+
+func itemIDs(items []ports.ProviderContentItem) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ProviderContentID
+	}
+	return ids
+}
+
+func TestFetchFeed_PagePagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"contents": [{"id": "a"}, {"id": "b"}]}`))
+		case "2":
+			w.Write([]byte(`{"contents": [{"id": "c"}]}`))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL: server.URL + "/feed?lang=en",
+		Pagination: entity.FeedPagination{
+			Strategy:  entity.PaginationPage,
+			Param:     "page",
+			SizeParam: "per_page",
+			PageSize:  2,
+		},
+	}
+
+	var items []ports.ProviderContentItem
+	result, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, itemIDs(items))
+	assert.Equal(t, 2, result.Pages)
+	assert.Equal(t, 3, result.ItemCount)
+	assert.False(t, result.Truncated)
+}
+
+func TestFetchFeed_CursorPagination(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("after") {
+			case "":
+				w.Write([]byte(`{"contents": [{"id": "a"}], "next": "c2"}`))
+			case "c2":
+				w.Write([]byte(`{"contents": [{"id": "b"}], "next": null}`))
+			}
+		}))
+		defer server.Close()
+
+		provider := entity.Provider{
+			BaseURL:    server.URL,
+			Pagination: entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "after", CursorField: "next"},
+		}
+
+		var items []ports.ProviderContentItem
+		_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, itemIDs(items))
+	})
+
+	t.Run("XML", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				w.Write([]byte(`<feed><items><item><id>a</id></item></items><next_cursor>42</next_cursor></feed>`))
+			case "42":
+				w.Write([]byte(`<feed><items><item><id>b</id></item></items></feed>`))
+			}
+		}))
+		defer server.Close()
+
+		provider := entity.Provider{
+			BaseURL:    server.URL,
+			Pagination: entity.FeedPagination{Strategy: entity.PaginationCursor, Param: "cursor", CursorField: "next_cursor"},
+		}
+
+		var items []ports.ProviderContentItem
+		_, err := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, itemIDs(items))
+	})
+}
+
+func TestFetchFeed_LinkPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed":
+			w.Header().Set("Link", `</feed/2>; rel="next", </feed/9>; rel="last"`)
+			w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+		case "/feed/2":
+			w.Write([]byte(`{"contents": [{"id": "b"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL + "/feed",
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationLink},
+	}
+
+	var items []ports.ProviderContentItem
+	result, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, itemIDs(items))
+	assert.Equal(t, 2, result.Pages)
+}
+
+func TestFetchFeed_MaxPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"contents": [{"id": "item-%s"}]}`, r.URL.Query().Get("offset"))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationOffset, Param: "offset", MaxPages: 3},
+	}
+
+	var items []ports.ProviderContentItem
+	result, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"item-0", "item-1", "item-2"}, itemIDs(items))
+	assert.True(t, result.Truncated)
+}
+
+func TestFetchFeed_PageRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
+	ctx := context.Background()
+
+	t.Run("Transient Failures Are Retried", func(t *testing.T) {
+		provider := entity.Provider{BaseURL: server.URL, Pagination: entity.FeedPagination{PageRetries: 2}}
+
+		var items []ports.ProviderContentItem
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, collectItems(&items))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("Client Errors Are Not Retried", func(t *testing.T) {
+		calls := 0
+		notFound := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer notFound.Close()
+
+		_, err := client.FetchContents(ctx, entity.Provider{BaseURL: notFound.URL}, entity.FeedValidators{}, collectItems(new([]ports.ProviderContentItem)))
+		assert.EqualError(t, err, "page 1: unexpected status code: 404")
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestFetchFeed_HandlerErrorStopsFetch(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationPage, Param: "page"},
+	}
+	handleErr := errors.New("deadlock detected")
+
+	_, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{}, func(context.Context, ports.ContentItems) error {
+		return handleErr
+	})
+	assert.ErrorIs(t, err, handleErr)
+	assert.Equal(t, 1, requests)
+}
+
+func TestFetchFeed_PaginatedFeedsAreNotConditional(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			// Page 1 is unchanged and would answer 304 to its validators.
+			if r.Header.Get("If-None-Match") == `"p1"` {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"p1"`)
+			w.Write([]byte(`{"contents": [{"id": "a"}]}`))
+		case "2":
+			w.Header().Set("ETag", `"p2-changed"`)
+			w.Write([]byte(`{"contents": [{"id": "b-changed"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider := entity.Provider{
+		BaseURL:    server.URL,
+		Pagination: entity.FeedPagination{Strategy: entity.PaginationPage, Param: "page", PageSize: 1, MaxPages: 2},
+	}
+
+	var items []ports.ProviderContentItem
+	result, err := NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), provider, entity.FeedValidators{ETag: `"p1"`}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.False(t, result.NotModified)
+	assert.Equal(t, []string{"a", "b-changed"}, itemIDs(items))
+	assert.Equal(t, entity.FeedValidators{}, result.Validators)
+}
+
+func TestNextLink(t *testing.T) {
+	assert.Equal(t, "https://api.example.com/items?page=3", nextLink([]string{`<https://api.example.com/items?page=1>; rel="prev", <https://api.example.com/items?page=3>; rel="next"`}))
+	assert.Equal(t, "/items/2", nextLink([]string{`</items/1>; rel=first`, `</items/2>; rel="next last"`}))
+	assert.Equal(t, "", nextLink([]string{`</items/1>; rel="prev"`}))
+	assert.Equal(t, "", nextLink(nil))
+}
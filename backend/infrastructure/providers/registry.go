@@ -0,0 +1,81 @@
+package providers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// ClientDecorator wraps the client registered for a format, e.g. in a
+// circuit breaker.
+type ClientDecorator func(format string, client ports.ProviderClient) ports.ProviderClient
+
+// ClientRegistry holds the provider client of each format. Every registered
+// client goes through the registry's decorators, in order, so all formats
+// get the same wrapping.
+type ClientRegistry struct {
+	decorators []ClientDecorator
+	clients    map[string]ports.ProviderClient
+}
+
+func NewClientRegistry(decorators ...ClientDecorator) *ClientRegistry {
+	return &ClientRegistry{
+		decorators: decorators,
+		clients:    make(map[string]ports.ProviderClient),
+	}
+}
+
+// NewBuiltinClientRegistry returns a registry holding the clients of every
+// format this package reads.
+func NewBuiltinClientRegistry(config entity.SyncConfig, secrets entity.FeedSecretsConfig, decorators ...ClientDecorator) *ClientRegistry {
+	registry := NewClientRegistry(decorators...)
+	registry.Register(entity.ProviderFormatJSON, NewJsonProviderClient(config, secrets))
+	registry.Register(entity.ProviderFormatXML, NewXmlProviderClient(config, secrets))
+	registry.Register(entity.ProviderFormatCSV, NewCsvProviderClient(config, secrets))
+	registry.Register(entity.ProviderFormatRSS, NewRssProviderClient(config, secrets))
+	return registry
+}
+
+// Register adds the client reading format. It panics if format is empty or
+// already has a client.
+func (r *ClientRegistry) Register(format string, client ports.ProviderClient) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" || client == nil {
+		panic("providers: Register needs a format and a client")
+	}
+	if _, ok := r.clients[format]; ok {
+		panic(fmt.Sprintf("providers: a client is already registered for format %q", format))
+	}
+
+	for _, decorate := range r.decorators {
+		client = decorate(format, client)
+	}
+	r.clients[format] = client
+}
+
+func (r *ClientRegistry) Client(format string) (ports.ProviderClient, bool) {
+	client, ok := r.clients[format]
+	return client, ok
+}
+
+func (r *ClientRegistry) Formats() []string {
+	return slices.Sorted(maps.Keys(r.clients))
+}
+
+// CheckFormats returns an error naming the formats without a client.
+func (r *ClientRegistry) CheckFormats(formats []string) error {
+	var missing []string
+	for _, format := range formats {
+		if _, ok := r.clients[format]; !ok {
+			missing = append(missing, format)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no provider client registered for formats: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRegistry(t *testing.T) {
+	var decorated []string
+	registry := NewBuiltinClientRegistry(entity.SyncConfig{}, entity.FeedSecretsConfig{}, func(format string, client ports.ProviderClient) ports.ProviderClient {
+		decorated = append(decorated, format)
+		return client
+	})
+
+	assert.Equal(t, []string{"csv", "json", "rss", "xml"}, registry.Formats())
+	assert.ElementsMatch(t, registry.Formats(), decorated)
+
+	client, ok := registry.Client(entity.ProviderFormatCSV)
+	assert.True(t, ok)
+	assert.IsType(t, &CsvProviderClient{}, client)
+	_, ok = registry.Client("yaml")
+	assert.False(t, ok)
+
+	assert.NoError(t, registry.CheckFormats([]string{"json", "xml"}))
+	assert.EqualError(t, registry.CheckFormats([]string{"json", "yaml", "parquet"}), "no provider client registered for formats: yaml, parquet")
+
+	assert.Panics(t, func() { registry.Register("JSON", NewJsonProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})) })
+}
@@ -0,0 +1,256 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
+)
+
+const (
+	atomNamespace = "http://www.w3.org/2005/Atom"
+	// readingWordsPerMinute estimates an article's reading time from the
+	// length of its text.
+	readingWordsPerMinute = 200
+)
+
+var (
+	rssDateLayouts = []string{
+		time.RFC1123Z,
+		time.RFC1123,
+		"Mon, 2 Jan 2006 15:04:05 -0700",
+		"Mon, 2 Jan 2006 15:04:05 MST",
+		"2 Jan 2006 15:04:05 -0700",
+		time.RFC3339,
+	}
+	htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+)
+
+type RssProviderClient struct {
+	client *feedHTTPClient
+	limits feedLimits
+}
+
+type rssItem struct {
+	GUID        string   `xml:"guid" json:"guid,omitempty"`
+	Links       []string `xml:"link" json:"links,omitempty"`
+	Title       string   `xml:"title" json:"title"`
+	Description string   `xml:"description" json:"description,omitempty"`
+	Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded" json:"content,omitempty"`
+	PubDate     string   `xml:"pubDate" json:"pub_date,omitempty"`
+	Categories  []string `xml:"category" json:"categories,omitempty"`
+	Enclosures  []struct {
+		URL  string `xml:"url,attr" json:"url"`
+		Type string `xml:"type,attr" json:"type"`
+	} `xml:"enclosure" json:"enclosures,omitempty"`
+	Media []struct {
+		Type   string `xml:"type,attr" json:"type,omitempty"`
+		Medium string `xml:"medium,attr" json:"medium,omitempty"`
+	} `xml:"http://search.yahoo.com/mrss/ content" json:"media,omitempty"`
+	Duration string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration" json:"duration,omitempty"`
+}
+
+type atomEntry struct {
+	ID    string `xml:"id" json:"id"`
+	Title string `xml:"title" json:"title"`
+	Links []struct {
+		Href string `xml:"href,attr" json:"href"`
+		Rel  string `xml:"rel,attr" json:"rel,omitempty"`
+		Type string `xml:"type,attr" json:"type,omitempty"`
+	} `xml:"link" json:"links,omitempty"`
+	Published  string `xml:"published" json:"published,omitempty"`
+	Updated    string `xml:"updated" json:"updated,omitempty"`
+	Summary    string `xml:"summary" json:"summary,omitempty"`
+	Content    string `xml:"content" json:"content,omitempty"`
+	Categories []struct {
+		Term string `xml:"term,attr" json:"term"`
+	} `xml:"category" json:"categories,omitempty"`
+}
+
+func NewRssProviderClient(config entity.SyncConfig, secrets entity.FeedSecretsConfig) ports.ProviderClient {
+	return &RssProviderClient{
+		client: newFeedHTTPClient(secrets),
+		limits: newFeedLimits(config),
+	}
+}
+
+// FetchContents reads an RSS 2.0 or Atom feed. Entries are articles unless
+// they enclose a video.
+func (p *RssProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	return fetchFeed(ctx, p.client, p.limits, provider, validators, decodeRSSPage, handle)
+}
+
+// decodeRSSPage reads <rss><channel><item> or Atom <feed><entry> elements
+// one at a time, skipping the rest of the document. Syndication feeds carry
+// no pagination cursor, so the cursor field is ignored.
+func decodeRSSPage(body io.Reader, _ string, yield func(ports.ProviderContentItem) bool) (string, error) {
+	decoder := xml.NewDecoder(body)
+	decoder.Entity = xml.HTMLEntity
+	depth := 0
+	atom := false
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("decode rss: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			var item ports.ProviderContentItem
+			switch {
+			case depth == 1:
+				switch {
+				case t.Name.Local == "rss":
+				case t.Name.Local == "feed" && t.Name.Space == atomNamespace:
+					atom = true
+				default:
+					return "", fmt.Errorf("decode rss: expected <rss> or an Atom <feed> but have <%s>", t.Name.Local)
+				}
+				continue
+			case !atom && depth == 2 && t.Name.Local == "channel":
+				continue
+			case !atom && depth == 3 && t.Name.Local == "item":
+				var rss rssItem
+				if err := decoder.DecodeElement(&rss, &t); err != nil {
+					return "", fmt.Errorf("decode rss: %w", err)
+				}
+				item = rss.contentItem()
+			case atom && depth == 2 && t.Name.Local == "entry":
+				var entry atomEntry
+				if err := decoder.DecodeElement(&entry, &t); err != nil {
+					return "", fmt.Errorf("decode rss: %w", err)
+				}
+				item = entry.contentItem()
+			default:
+				if err := decoder.Skip(); err != nil {
+					return "", fmt.Errorf("decode rss: %w", err)
+				}
+				depth--
+				continue
+			}
+
+			depth--
+			if !yield(item) {
+				return "", nil
+			}
+		case xml.EndElement:
+			depth--
+			if depth == 0 {
+				return "", nil
+			}
+		}
+	}
+}
+
+func (item rssItem) contentItem() ports.ProviderContentItem {
+	link := ""
+	for _, l := range item.Links {
+		if l = strings.TrimSpace(l); l != "" {
+			link = l
+			break
+		}
+	}
+
+	video := false
+	for _, enclosure := range item.Enclosures {
+		video = video || strings.HasPrefix(enclosure.Type, "video/")
+	}
+	for _, media := range item.Media {
+		video = video || media.Medium == "video" || strings.HasPrefix(media.Type, "video/")
+	}
+
+	content := ports.ProviderContentItem{
+		ProviderContentID: firstNonEmpty(item.GUID, link),
+		Title:             strings.TrimSpace(item.Title),
+		PublishedAt:       parseFeedDate(item.PubDate),
+		Tags:              trimmedValues(item.Categories),
+	}
+	content.RawPayload, _ = json.Marshal(item)
+	return syndicatedItem(content, video, firstNonEmpty(item.Content, item.Description), item.Duration)
+}
+
+func (entry atomEntry) contentItem() ports.ProviderContentItem {
+	link := ""
+	video := false
+	for _, l := range entry.Links {
+		switch l.Rel {
+		case "", "alternate":
+			if link == "" {
+				link = strings.TrimSpace(l.Href)
+			}
+		case "enclosure":
+			video = video || strings.HasPrefix(l.Type, "video/")
+		}
+	}
+
+	tags := make([]string, 0, len(entry.Categories))
+	for _, category := range entry.Categories {
+		tags = append(tags, category.Term)
+	}
+
+	content := ports.ProviderContentItem{
+		ProviderContentID: firstNonEmpty(entry.ID, link),
+		Title:             strings.TrimSpace(entry.Title),
+		PublishedAt:       parseFeedDate(firstNonEmpty(entry.Published, entry.Updated)),
+		Tags:              trimmedValues(tags),
+	}
+	content.RawPayload, _ = json.Marshal(entry)
+	return syndicatedItem(content, video, firstNonEmpty(entry.Content, entry.Summary), "")
+}
+
+// syndicatedItem types a feed entry: videos take their duration, articles
+// a reading time estimated from text.
+func syndicatedItem(item ports.ProviderContentItem, video bool, text, duration string) ports.ProviderContentItem {
+	if video {
+		item.ContentType = string(entity.ContentTypeVideo)
+		if seconds, err := service.ParseDurationSeconds(duration); err == nil {
+			item.DurationSec = int32(seconds)
+		}
+		return item
+	}
+
+	item.ContentType = string(entity.ContentTypeArticle)
+	if words := len(strings.Fields(htmlTagPattern.ReplaceAllString(text, " "))); words > 0 {
+		item.ReadingTime = int32((words + readingWordsPerMinute - 1) / readingWordsPerMinute)
+	}
+	return item
+}
+
+func parseFeedDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range rssDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func trimmedValues(values []string) []string {
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			trimmed = append(trimmed, value)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,106 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRssProviderClient_FetchContents(t *testing.T) {
+	mockResponse := `<?xml version="1.0" encoding="UTF-8"?>
+	<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Example</title>
+			<atom:link href="https://example.com/rss" rel="self"/>
+			<image><title>Logo</title></image>
+			<item>
+				<title>Release &amp; Notes&nbsp;</title>
+				<link>https://example.com/posts/1</link>
+				<description>&lt;p&gt;` + strings.Repeat("word ", 250) + `&lt;/p&gt;</description>
+				<pubDate>Tue, 5 Mar 2024 10:00:00 +0000</pubDate>
+				<category>release</category>
+				<category> go </category>
+			</item>
+			<item>
+				<guid isPermaLink="false">ep-2</guid>
+				<title>Episode 2</title>
+				<enclosure url="https://example.com/ep2.mp4" type="video/mp4" length="1"/>
+				<itunes:duration>12:30</itunes:duration>
+			</item>
+		</channel>
+	</rss>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	var items []ports.ProviderContentItem
+	_, err := NewRssProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/posts/1", "ep-2"}, itemIDs(items))
+
+	article := items[0]
+	assert.Equal(t, "Release & Notes", article.Title)
+	assert.Equal(t, "article", article.ContentType)
+	assert.Equal(t, int32(2), article.ReadingTime)
+	assert.Equal(t, time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC), article.PublishedAt.UTC())
+	assert.Equal(t, []string{"release", "go"}, article.Tags)
+	assert.NotEmpty(t, article.RawPayload)
+
+	video := items[1]
+	assert.Equal(t, "video", video.ContentType)
+	assert.Equal(t, int32(750), video.DurationSec)
+	assert.True(t, video.PublishedAt.IsZero())
+}
+
+func TestRssProviderClient_Atom(t *testing.T) {
+	mockResponse := `<feed xmlns="http://www.w3.org/2005/Atom">
+		<title>Example</title>
+		<entry>
+			<id>urn:uuid:1</id>
+			<title type="text">Atom Entry</title>
+			<link rel="alternate" href="https://example.com/a/1"/>
+			<updated>2024-02-01T08:00:00Z</updated>
+			<summary>Short summary</summary>
+			<category term="atom"/>
+		</entry>
+		<entry>
+			<title>Linked Only</title>
+			<link href="https://example.com/a/2"/>
+			<link rel="enclosure" type="video/webm" href="https://example.com/a/2.webm"/>
+			<published>2024-02-02T08:00:00Z</published>
+		</entry>
+	</feed>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(mockResponse))
+	}))
+	defer server.Close()
+
+	var items []ports.ProviderContentItem
+	_, err := NewRssProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{}).FetchContents(context.Background(), entity.Provider{BaseURL: server.URL}, entity.FeedValidators{}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"urn:uuid:1", "https://example.com/a/2"}, itemIDs(items))
+
+	assert.Equal(t, "Atom Entry", items[0].Title)
+	assert.Equal(t, "article", items[0].ContentType)
+	assert.Equal(t, int32(1), items[0].ReadingTime)
+	assert.Equal(t, time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC), items[0].PublishedAt)
+	assert.Equal(t, []string{"atom"}, items[0].Tags)
+
+	assert.Equal(t, "video", items[1].ContentType)
+	assert.Equal(t, time.Date(2024, 2, 2, 8, 0, 0, 0, time.UTC), items[1].PublishedAt)
+}
+
+func TestDecodeRSSPage_RejectsOtherRoots(t *testing.T) {
+	_, err := decodeRSSPage(strings.NewReader(`<feed><items/></feed>`), "", func(ports.ProviderContentItem) bool { return true })
+	assert.EqualError(t, err, "decode rss: expected <rss> or an Atom <feed> but have <feed>")
+}
@@ -6,7 +6,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"net/http"
+	"strings"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -14,14 +14,8 @@ import (
 )
 
 type XmlProviderClient struct {
-	client *http.Client
-}
-
-type xmlResponse struct {
-	XMLName xml.Name `xml:"feed"`
-	Items   struct {
-		ItemList []xmlItem `xml:"item"`
-	} `xml:"items"`
+	client *feedHTTPClient
+	limits feedLimits
 }
 
 type xmlItem struct {
@@ -57,71 +51,109 @@ func parseXMLDuration(durationStr string) int32 {
 	return totalSeconds
 }
 
-func NewXmlProviderClient() ports.ProviderClient {
+func NewXmlProviderClient(config entity.SyncConfig, secrets entity.FeedSecretsConfig) ports.ProviderClient {
 	return &XmlProviderClient{
-		client: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		client: newFeedHTTPClient(secrets),
+		limits: newFeedLimits(config),
 	}
 }
 
-func (p *XmlProviderClient) FetchContents(ctx context.Context, provider entity.Provider) ([]ports.ProviderContentItem, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.BaseURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
+// FetchContents reads the feed in the built-in schema below unless the
+// provider carries a feed mapping.
+func (p *XmlProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	decode := pageDecoder(decodeXMLPage)
+	if !provider.Mapping.IsZero() {
+		mapping, err := compileMapping(entity.ProviderFormatXML, provider.Mapping)
+		if err != nil {
+			return nil, fmt.Errorf("compile feed mapping: %w", err)
+		}
+		decode = decodeMappedXMLPage(mapping)
 	}
+	return fetchFeed(ctx, p.client, p.limits, provider, validators, decode, handle)
+}
 
-	resp, err := p.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("fetch data: %w", err)
-	}
-	defer resp.Body.Close()
+// decodeXMLPage reads the <feed> document token by token and decodes each
+// <item> of <items> on its own, so only one item is held in memory at a
+// time. A pagination cursor is read from the top-level element named
+// cursorField.
+func decodeXMLPage(body io.Reader, cursorField string, yield func(ports.ProviderContentItem) bool) (string, error) {
+	decoder := xml.NewDecoder(body)
+	var cursor string
+	depth := 0
+	inItems := false
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("decode xml: %w", err)
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			switch {
+			case depth == 1:
+				if t.Name.Local != "feed" {
+					return "", fmt.Errorf("decode xml: expected element type <feed> but have <%s>", t.Name.Local)
+				}
+			case depth == 2 && t.Name.Local == "items":
+				inItems = true
+			case depth == 3 && inItems && t.Name.Local == "item":
+				var item xmlItem
+				if err := decoder.DecodeElement(&item, &t); err != nil {
+					return "", fmt.Errorf("decode xml: %w", err)
+				}
+				depth--
+				if !yield(toXMLContentItem(item)) {
+					return cursor, nil
+				}
+			case depth == 2 && cursorField != "" && t.Name.Local == cursorField:
+				var value string
+				if err := decoder.DecodeElement(&value, &t); err != nil {
+					return "", fmt.Errorf("decode xml: %w", err)
+				}
+				depth--
+				cursor = strings.TrimSpace(value)
+			}
+		case xml.EndElement:
+			depth--
+			if depth == 1 {
+				inItems = false
+			}
+			if depth == 0 {
+				return cursor, nil
+			}
+		}
 	}
+}
 
-	body, err := io.ReadAll(resp.Body)
+func toXMLContentItem(item xmlItem) ports.ProviderContentItem {
+	pubDate, err := time.Parse("2006-01-02", item.PublicationDate)
 	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+		pubDate = time.Now()
 	}
 
-	var data xmlResponse
-	if err := xml.Unmarshal(body, &data); err != nil {
-		return nil, fmt.Errorf("decode xml: %w", err)
+	itemPayload, _ := json.Marshal(map[string]any{
+		"id":               item.ID,
+		"headline":         item.Headline,
+		"type":             item.Type,
+		"stats":            item.Stats,
+		"publication_date": item.PublicationDate,
+		"categories":       item.Categories.CategoryList,
+	})
+
+	return ports.ProviderContentItem{
+		ProviderContentID: item.ID,
+		Title:             item.Headline,
+		ContentType:       item.Type,
+		Views:             item.Stats.Views,
+		Likes:             item.Stats.Likes,
+		DurationSec:       parseXMLDuration(item.Stats.Duration),
+		ReadingTime:       item.Stats.ReadingTime,
+		Reactions:         item.Stats.Reactions,
+		Comments:          item.Stats.Comments,
+		PublishedAt:       pubDate,
+		Tags:              item.Categories.CategoryList,
+		RawPayload:        itemPayload,
 	}
-
-	items := make([]ports.ProviderContentItem, 0, len(data.Items.ItemList))
-	for _, item := range data.Items.ItemList {
-		pubDate, err := time.Parse("2006-01-02", item.PublicationDate)
-		if err != nil {
-			pubDate = time.Now()
-		}
-
-		itemPayload, _ := json.Marshal(map[string]any{
-			"id":               item.ID,
-			"headline":         item.Headline,
-			"type":             item.Type,
-			"stats":            item.Stats,
-			"publication_date": item.PublicationDate,
-			"categories":       item.Categories.CategoryList,
-		})
-
-		items = append(items, ports.ProviderContentItem{
-			ProviderContentID: item.ID,
-			Title:             item.Headline,
-			ContentType:       item.Type,
-			Views:             item.Stats.Views,
-			Likes:             item.Stats.Likes,
-			DurationSec:       parseXMLDuration(item.Stats.Duration),
-			ReadingTime:       item.Stats.ReadingTime,
-			Reactions:         item.Stats.Reactions,
-			Comments:          item.Stats.Comments,
-			PublishedAt:       pubDate,
-			Tags:              item.Categories.CategoryList,
-			RawPayload:        itemPayload,
-		})
-	}
-
-	return items, nil
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,13 +42,14 @@ func TestXmlProviderClient_FetchContents(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewXmlProviderClient()
+	client := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
 	provider := entity.Provider{
 		BaseURL: server.URL,
 	}
 
 	ctx := context.Background()
-	items, err := client.FetchContents(ctx, provider)
+	var items []ports.ProviderContentItem
+	_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, collectItems(&items))
 
 	assert.NoError(t, err)
 	assert.Len(t, items, 1)
@@ -82,10 +84,31 @@ func TestXmlProviderClient_FetchContents_DurationParsing(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewXmlProviderClient()
+	client := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
 	provider := entity.Provider{BaseURL: server.URL}
 
-	items, err := client.FetchContents(context.Background(), provider)
+	var items []ports.ProviderContentItem
+	_, err := client.FetchContents(context.Background(), provider, entity.FeedValidators{}, collectItems(&items))
 	assert.NoError(t, err)
 	assert.Equal(t, int32(150), items[0].DurationSec) // 2*60 + 30 = 150
 }
+
+func TestXmlProviderClient_FetchContents_NotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == "Thu, 26 Oct 2023 08:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewXmlProviderClient(entity.SyncConfig{}, entity.FeedSecretsConfig{})
+	provider := entity.Provider{BaseURL: server.URL}
+
+	var items []ports.ProviderContentItem
+	result, err := client.FetchContents(context.Background(), provider, entity.FeedValidators{LastModified: "Thu, 26 Oct 2023 08:00:00 GMT"}, collectItems(&items))
+	assert.NoError(t, err)
+	assert.True(t, result.NotModified)
+	assert.Empty(t, items)
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// AdvisoryLocker implements ports.Locker with Postgres session-level advisory
+// locks. Each lock pins its own connection, and Postgres releases the lock
+// when that session ends, so a crashed holder never blocks other replicas.
+type AdvisoryLocker struct {
+	db *sql.DB
+}
+
+func NewAdvisoryLocker(database *sql.DB) ports.Locker {
+	return &AdvisoryLocker{db: database}
+}
+
+func (l *AdvisoryLocker) TryLock(ctx context.Context, key string) (ports.Lock, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get lock connection: %w", err)
+	}
+
+	queries := db.New(conn)
+	acquired, err := queries.TryAdvisoryLock(ctx, key)
+	if err != nil {
+		discardConn(conn)
+		return nil, fmt.Errorf("try advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, nil
+	}
+
+	return &advisoryLock{
+		conn:    conn,
+		queries: queries,
+		key:     key,
+	}, nil
+}
+
+type advisoryLock struct {
+	conn    *sql.Conn
+	queries *db.Queries
+	key     string
+}
+
+func (l *advisoryLock) Check(ctx context.Context) error {
+	if err := l.conn.PingContext(ctx); err != nil {
+		return fmt.Errorf("ping lock connection: %w", err)
+	}
+	return nil
+}
+
+func (l *advisoryLock) Release(ctx context.Context) error {
+	released, err := l.queries.ReleaseAdvisoryLock(ctx, l.key)
+	if err != nil || !released {
+		// The session may still hold the lock, so it must not go back to the
+		// pool; closing it makes Postgres drop the lock.
+		discardConn(l.conn)
+		if err != nil {
+			return fmt.Errorf("release advisory lock: %w", err)
+		}
+		return fmt.Errorf("release advisory lock: %s was not held", l.key)
+	}
+	return l.conn.Close()
+}
+
+// discardConn closes the connection's session instead of returning it to the
+// pool.
+func discardConn(conn *sql.Conn) {
+	conn.Raw(func(any) error {
+		return driver.ErrBadConn
+	})
+	conn.Close()
+}
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+// CachedProviderRepository keeps every provider in memory, as they are few
+// and looked up for each search result. The snapshot is reloaded after ttl
+// and dropped whenever a provider is written through this repository.
+type CachedProviderRepository struct {
+	next ports.ProviderRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.RWMutex
+	loadedAt time.Time
+	// generation is bumped on invalidation so that a load racing with a
+	// write does not store what it read before the write.
+	generation uint64
+	all        []entity.Provider
+	byID       map[int64]entity.Provider
+	byCode     map[string]entity.Provider
+}
+
+func NewCachedProviderRepository(next ports.ProviderRepository, ttl time.Duration, now func() time.Time) ports.ProviderRepository {
+	return &CachedProviderRepository{
+		next: next,
+		ttl:  ttl,
+		now:  now,
+	}
+}
+
+func (r *CachedProviderRepository) GetAllEnabled(ctx context.Context) ([]entity.Provider, error) {
+	all, err := r.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make([]entity.Provider, 0, len(all))
+	for _, provider := range all {
+		if provider.IsEnabled {
+			enabled = append(enabled, provider)
+		}
+	}
+	return enabled, nil
+}
+
+func (r *CachedProviderRepository) ListAll(ctx context.Context) ([]entity.Provider, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	all := make([]entity.Provider, len(r.all))
+	copy(all, r.all)
+	return all, nil
+}
+
+func (r *CachedProviderRepository) GetByCode(ctx context.Context, code string) (*entity.Provider, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	provider, ok := r.byCode[code]
+	if !ok {
+		return nil, nil
+	}
+	return &provider, nil
+}
+
+func (r *CachedProviderRepository) GetByID(ctx context.Context, id int64) (*entity.Provider, error) {
+	if err := r.load(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	provider, ok := r.byID[id]
+	if !ok {
+		return nil, nil
+	}
+	return &provider, nil
+}
+
+func (r *CachedProviderRepository) UpsertProvider(ctx context.Context, provider entity.Provider) error {
+	defer r.invalidate()
+	return r.next.UpsertProvider(ctx, provider)
+}
+
+func (r *CachedProviderRepository) HasContents(ctx context.Context, id int64) (bool, error) {
+	return r.next.HasContents(ctx, id)
+}
+
+func (r *CachedProviderRepository) Delete(ctx context.Context, id int64) error {
+	defer r.invalidate()
+	return r.next.Delete(ctx, id)
+}
+
+// invalidate drops the snapshot so the next lookup reloads it.
+func (r *CachedProviderRepository) invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loadedAt = time.Time{}
+	r.generation++
+}
+
+func (r *CachedProviderRepository) load(ctx context.Context) error {
+	r.mu.RLock()
+	fresh := !r.loadedAt.IsZero() && r.now().Sub(r.loadedAt) < r.ttl
+	generation := r.generation
+	r.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	providers, err := r.next.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[int64]entity.Provider, len(providers))
+	byCode := make(map[string]entity.Provider, len(providers))
+	for _, provider := range providers {
+		byID[provider.ID] = provider
+		byCode[provider.Code] = provider
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.all = providers
+	r.byID = byID
+	r.byCode = byCode
+	// A write during the load may not be reflected, so keep the snapshot
+	// stale and let the next lookup reload it.
+	if r.generation == generation {
+		r.loadedAt = r.now()
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedProviderRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	providers := []entity.Provider{
+		{ID: 1, Code: "provider1", Name: "Provider One", IsEnabled: true},
+		{ID: 2, Code: "provider2", Name: "Provider Two"},
+	}
+
+	t.Run("Serves Lookups From One Load", func(t *testing.T) {
+		next := new(mocks.MockProviderRepository)
+		next.On("ListAll", ctx).Return(providers, nil).Once()
+		repo := NewCachedProviderRepository(next, time.Minute, clock)
+
+		provider, err := repo.GetByID(ctx, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, "provider2", provider.Code)
+
+		provider, err = repo.GetByCode(ctx, "provider1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), provider.ID)
+
+		missing, err := repo.GetByCode(ctx, "unknown")
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+
+		enabled, err := repo.GetAllEnabled(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, providers[:1], enabled)
+		next.AssertExpectations(t)
+	})
+
+	t.Run("Reloads After Upsert And TTL", func(t *testing.T) {
+		next := new(mocks.MockProviderRepository)
+		next.On("ListAll", ctx).Return(providers, nil).Times(3)
+		next.On("UpsertProvider", ctx, providers[0]).Return(nil).Once()
+		repo := NewCachedProviderRepository(next, time.Minute, clock)
+
+		_, err := repo.ListAll(ctx)
+		assert.NoError(t, err)
+
+		assert.NoError(t, repo.UpsertProvider(ctx, providers[0]))
+		_, err = repo.ListAll(ctx)
+		assert.NoError(t, err)
+
+		now = now.Add(2 * time.Minute)
+		_, err = repo.ListAll(ctx)
+		assert.NoError(t, err)
+		next.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type ContentRawPayloadRepositorySqlc struct {
+	db      *sql.DB
+	queries *db.Queries
+}
+
+func NewContentRawPayloadRepository(database *sql.DB) ports.ContentRawPayloadRepository {
+	return &ContentRawPayloadRepositorySqlc{
+		db:      database,
+		queries: db.New(database),
+	}
+}
+
+func (r *ContentRawPayloadRepositorySqlc) SaveOrUpdatePayloads(ctx context.Context, payloads []entity.ContentRawPayload) error {
+	return withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		for _, payload := range payloads {
+			err := qtx.UpsertContentRawPayload(ctx, db.UpsertContentRawPayloadParams{
+				ContentID:  payload.ContentID,
+				ProviderID: payload.ProviderID,
+				RawPayload: payload.RawPayload,
+				FetchedAt:  payload.FetchedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("upsert content raw payload: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ContentRawPayloadRepositorySqlc) GetByContentID(ctx context.Context, contentID int64) (*entity.ContentRawPayload, error) {
+	row, err := r.queries.GetContentRawPayloadByContentID(ctx, contentID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get content raw payload: %w", err)
+	}
+
+	return &entity.ContentRawPayload{
+		ContentID:  row.ContentID,
+		ProviderID: row.ProviderID,
+		RawPayload: row.RawPayload,
+		FetchedAt:  row.FetchedAt,
+	}, nil
+}
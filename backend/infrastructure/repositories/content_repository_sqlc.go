@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -11,85 +14,174 @@ import (
 )
 
 type ContentRepositorySqlc struct {
-	db      *sql.DB
-	queries *db.Queries
+	db             *sql.DB
+	queries        *db.Queries
+	searchLanguage string
 }
 
-func NewContentRepository(database *sql.DB) ports.ContentRepository {
+func NewContentRepository(database *sql.DB, searchLanguage string) ports.ContentRepository {
 	return &ContentRepositorySqlc{
-		db:      database,
-		queries: db.New(database),
+		db:             database,
+		queries:        db.New(database),
+		searchLanguage: searchLanguage,
 	}
 }
 
-func (r *ContentRepositorySqlc) SaveOrUpdateContents(ctx context.Context, contents []entity.Content) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
-	}
-	defer tx.Rollback()
+func (r *ContentRepositorySqlc) SaveOrUpdateContents(ctx context.Context, contents []entity.Content) (map[string]int64, error) {
+	ids := make(map[string]int64, len(contents))
 
-	qtx := r.queries.WithTx(tx)
-
-	for _, content := range contents {
-		_, err := qtx.UpsertContent(ctx, db.UpsertContentParams{
-			ProviderID:        content.ProviderID,
-			ProviderContentID: content.ProviderContentID,
-			Title:             content.Title,
-			ContentType:       string(content.ContentType),
-			PublishedAt:       content.PublishedAt,
-			IsActive:          content.IsActive,
-		})
-		if err != nil {
-			return fmt.Errorf("upsert content: %w", err)
+	err := withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		for _, content := range contents {
+			id, err := qtx.UpsertContent(ctx, db.UpsertContentParams{
+				ProviderID:        content.ProviderID,
+				ProviderContentID: content.ProviderContentID,
+				Title:             content.Title,
+				ContentType:       string(content.ContentType),
+				PublishedAt:       content.PublishedAt,
+				IsActive:          content.IsActive,
+				ContentHash:       content.ContentHash,
+			})
+			if err != nil {
+				return fmt.Errorf("upsert content: %w", err)
+			}
+			ids[content.ProviderContentID] = id
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
-	}
-
-	return nil
+	return ids, nil
 }
 
-func (r *ContentRepositorySqlc) SearchContents(ctx context.Context, filters ports.SearchFilters, pagination ports.Pagination) ([]entity.Content, int64, error) {
-	var queryParam sql.NullString
-	if filters.Query != "" {
-		queryParam = sql.NullString{String: filters.Query, Valid: true}
-	}
+func (r *ContentRepositorySqlc) SearchContents(ctx context.Context, filters ports.SearchFilters, pagination ports.Pagination) ([]ports.ContentMatch, int64, error) {
+	tsQueryParam, queryParam := queryParams(filters)
+	contentTypeParam := nullContentType(filters.ContentType)
 
-	var contentTypeParam sql.NullString
-	if filters.ContentType != nil {
-		contentTypeParam = sql.NullString{
-			String: string(*filters.ContentType),
-			Valid:  true,
-		}
+	sortOrder := filters.Sort
+	if sortOrder == "" {
+		sortOrder = ports.SortByScoreDesc
 	}
 
 	rows, err := r.queries.SearchContents(ctx, db.SearchContentsParams{
-		Query:       queryParam,
-		ContentType: contentTypeParam,
-		LimitCount:  pagination.Limit(),
-		OffsetCount: pagination.Offset(),
+		Language:        r.searchLanguage,
+		TsQuery:         tsQueryParam,
+		Fuzzy:           filters.Fuzzy,
+		Query:           queryParam,
+		ContentType:     contentTypeParam,
+		IncludeTags:     nonNilStrings(filters.IncludeTags),
+		MatchAllTags:    filters.MatchAllTags,
+		ExcludeTags:     nonNilStrings(filters.ExcludeTags),
+		PublishedAfter:  nullTime(filters.PublishedAfter),
+		PublishedBefore: nullTime(filters.PublishedBefore),
+		ProviderIds:     nonNilInt64s(filters.ProviderIDs),
+		SortOrder:       string(sortOrder),
+		RelevanceWeight: filters.RelevanceWeight,
+		LimitCount:      pagination.Limit(),
+		OffsetCount:     pagination.Offset(),
 	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("search contents: %w", err)
 	}
 
-	contents := make([]entity.Content, 0, len(rows))
+	matches := make([]ports.ContentMatch, 0, len(rows))
 	for _, row := range rows {
-		contents = append(contents, dbRowToContent(row))
+		matches = append(matches, ports.ContentMatch{
+			Content: dbRowToContent(db.Content{
+				ID:                row.ID,
+				ProviderID:        row.ProviderID,
+				ProviderContentID: row.ProviderContentID,
+				Title:             row.Title,
+				ContentType:       row.ContentType,
+				PublishedAt:       row.PublishedAt,
+				IsActive:          row.IsActive,
+				CreatedAt:         row.CreatedAt,
+				UpdatedAt:         row.UpdatedAt,
+				LastSeenAt:        row.LastSeenAt,
+				MissedSyncCount:   row.MissedSyncCount,
+			}),
+			Relevance: row.Relevance,
+		})
 	}
 
 	count, err := r.queries.CountContents(ctx, db.CountContentsParams{
-		Query:       queryParam,
-		ContentType: contentTypeParam,
+		TsQuery:         tsQueryParam,
+		Language:        r.searchLanguage,
+		Query:           queryParam,
+		Fuzzy:           filters.Fuzzy,
+		ContentType:     contentTypeParam,
+		IncludeTags:     nonNilStrings(filters.IncludeTags),
+		MatchAllTags:    filters.MatchAllTags,
+		ExcludeTags:     nonNilStrings(filters.ExcludeTags),
+		PublishedAfter:  nullTime(filters.PublishedAfter),
+		PublishedBefore: nullTime(filters.PublishedBefore),
+		ProviderIds:     nonNilInt64s(filters.ProviderIDs),
 	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("count contents: %w", err)
 	}
 
-	return contents, count, nil
+	return matches, count, nil
+}
+
+func (r *ContentRepositorySqlc) SearchFacets(ctx context.Context, filters ports.SearchFilters, tagLimit int32) (entity.SearchFacets, error) {
+	tsQueryParam, queryParam := queryParams(filters)
+
+	rows, err := r.queries.SearchFacets(ctx, db.SearchFacetsParams{
+		TsQuery:         tsQueryParam,
+		Language:        r.searchLanguage,
+		Query:           queryParam,
+		Fuzzy:           filters.Fuzzy,
+		ContentType:     nullContentType(filters.ContentType),
+		IncludeTags:     nonNilStrings(filters.IncludeTags),
+		MatchAllTags:    filters.MatchAllTags,
+		ExcludeTags:     nonNilStrings(filters.ExcludeTags),
+		PublishedAfter:  nullTime(filters.PublishedAfter),
+		PublishedBefore: nullTime(filters.PublishedBefore),
+		ProviderIds:     nonNilInt64s(filters.ProviderIDs),
+		TagLimit:        tagLimit,
+	})
+	if err != nil {
+		return entity.SearchFacets{}, fmt.Errorf("search facets: %w", err)
+	}
+
+	facets := entity.SearchFacets{
+		ContentTypes: []entity.FacetValue{},
+		Providers:    []entity.FacetValue{},
+		Tags:         []entity.FacetValue{},
+		PublishedAt:  []entity.FacetValue{},
+	}
+	published := make(map[string]entity.FacetValue, 4)
+	for _, row := range rows {
+		value := entity.FacetValue{Value: row.Value, Label: row.Label, Count: row.Count}
+		switch row.Facet {
+		case "content_type":
+			facets.ContentTypes = append(facets.ContentTypes, value)
+		case "provider":
+			facets.Providers = append(facets.Providers, value)
+		case "tag":
+			facets.Tags = append(facets.Tags, value)
+		case "published_at":
+			published[row.Value] = value
+		}
+	}
+
+	sortFacetValues(facets.ContentTypes)
+	sortFacetValues(facets.Providers)
+	sortFacetValues(facets.Tags)
+	for _, bucket := range []string{
+		entity.PublishedPastDay,
+		entity.PublishedPastWeek,
+		entity.PublishedPastMonth,
+		entity.PublishedPastYear,
+	} {
+		if value, ok := published[bucket]; ok {
+			facets.PublishedAt = append(facets.PublishedAt, value)
+		}
+	}
+
+	return facets, nil
 }
 
 func (r *ContentRepositorySqlc) GetByIDs(ctx context.Context, ids []int64) ([]entity.Content, error) {
@@ -119,8 +211,180 @@ func (r *ContentRepositorySqlc) GetByID(ctx context.Context, id int64) (*entity.
 	return &content, nil
 }
 
+func (r *ContentRepositorySqlc) CountActiveByProvider(ctx context.Context, providerID int64) (int64, error) {
+	count, err := r.queries.CountActiveContentsByProvider(ctx, providerID)
+	if err != nil {
+		return 0, fmt.Errorf("count active contents by provider: %w", err)
+	}
+	return count, nil
+}
+
+// ReconcileProviderContents reactivates seen contents and, when allowed,
+// counts a miss against every active content absent from the fetch and
+// deactivates those that have missed enough syncs.
+func (r *ContentRepositorySqlc) ReconcileProviderContents(ctx context.Context, providerID int64, seenProviderContentIDs []string, opts ports.ReconcileOptions) (ports.ContentReconciliation, error) {
+	var result ports.ContentReconciliation
+
+	err := withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		reactivated, err := qtx.ReactivateSeenContents(ctx, db.ReactivateSeenContentsParams{
+			ProviderID:         providerID,
+			ProviderContentIds: seenProviderContentIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("reactivate seen contents: %w", err)
+		}
+		result.Reactivated = reactivated
+
+		if !opts.DeactivateMissing {
+			return nil
+		}
+
+		err = qtx.IncrementMissedSyncCount(ctx, db.IncrementMissedSyncCountParams{
+			ProviderID:         providerID,
+			ProviderContentIds: seenProviderContentIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("increment missed sync count: %w", err)
+		}
+
+		deactivated, err := qtx.DeactivateMissingContents(ctx, db.DeactivateMissingContentsParams{
+			ProviderID:     providerID,
+			MinMissedSyncs: opts.MinMissedSyncs,
+			SeenBefore:     opts.SeenBefore,
+		})
+		if err != nil {
+			return fmt.Errorf("deactivate missing contents: %w", err)
+		}
+		result.Deactivated = deactivated
+		return nil
+	})
+	if err != nil {
+		return ports.ContentReconciliation{}, err
+	}
+
+	return result, nil
+}
+
+func (r *ContentRepositorySqlc) GetContentHashes(ctx context.Context, providerID int64, providerContentIDs []string) (map[string]string, error) {
+	rows, err := queriesFor(ctx, r.queries).GetContentHashes(ctx, db.GetContentHashesParams{
+		ProviderID:         providerID,
+		ProviderContentIds: nonNilStrings(providerContentIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get content hashes: %w", err)
+	}
+
+	hashes := make(map[string]string, len(rows))
+	for _, row := range rows {
+		hashes[row.ProviderContentID] = row.ContentHash
+	}
+	return hashes, nil
+}
+
+func (r *ContentRepositorySqlc) MarkSeen(ctx context.Context, providerID int64, providerContentIDs []string) error {
+	err := queriesFor(ctx, r.queries).MarkContentsSeen(ctx, db.MarkContentsSeenParams{
+		ProviderID:         providerID,
+		ProviderContentIds: nonNilStrings(providerContentIDs),
+	})
+	if err != nil {
+		return fmt.Errorf("mark contents seen: %w", err)
+	}
+	return nil
+}
+
+func (r *ContentRepositorySqlc) AutocompleteTitles(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error) {
+	titles, err := r.queries.AutocompleteTitles(ctx, db.AutocompleteTitlesParams{
+		Prefix:      escapeLike(prefix),
+		ContentType: nullContentType(contentType),
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete titles: %w", err)
+	}
+	return titles, nil
+}
+
+func (r *ContentRepositorySqlc) RefreshSearchDocuments(ctx context.Context, contentIDs []int64) error {
+	queries := queriesFor(ctx, r.queries)
+	err := queries.UpsertContentSearchDocuments(ctx, db.UpsertContentSearchDocumentsParams{
+		Language:   r.searchLanguage,
+		ContentIds: contentIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert content search documents: %w", err)
+	}
+
+	if err := queries.UpsertSearchTerms(ctx, contentIDs); err != nil {
+		return fmt.Errorf("upsert search terms: %w", err)
+	}
+	return nil
+}
+
+func queryParams(filters ports.SearchFilters) (tsQuery, query sql.NullString) {
+	if filters.FullTextQuery != "" {
+		return sql.NullString{String: filters.FullTextQuery, Valid: true}, sql.NullString{}
+	}
+	if filters.Query != "" {
+		return sql.NullString{}, sql.NullString{String: filters.Query, Valid: true}
+	}
+	return sql.NullString{}, sql.NullString{}
+}
+
+// sortFacetValues orders facet values by count, most frequent first, then by
+// value so that ties are stable across requests.
+func sortFacetValues(values []entity.FacetValue) {
+	sort.SliceStable(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+}
+
+func nullContentType(contentType *entity.ContentType) sql.NullString {
+	if contentType == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: string(*contentType), Valid: true}
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func nonNilInt64s(values []int64) []int64 {
+	if values == nil {
+		return []int64{}
+	}
+	return values
+}
+
+// nonNilStrings keeps empty filters as empty arrays; pq sends a nil slice as
+// NULL, which cardinality() and ANY() would not treat as "no tags".
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// escapeLike escapes LIKE wildcards so s is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func dbRowToContent(row db.Content) entity.Content {
 	contentTypeStr := row.ContentType
+
+	var lastSeenAt *time.Time
+	if row.LastSeenAt.Valid {
+		seenAt := row.LastSeenAt.Time
+		lastSeenAt = &seenAt
+	}
+
 	return entity.Content{
 		ID:                row.ID,
 		ProviderID:        row.ProviderID,
@@ -131,5 +395,8 @@ func dbRowToContent(row db.Content) entity.Content {
 		IsActive:          row.IsActive,
 		CreatedAt:         row.CreatedAt,
 		UpdatedAt:         row.UpdatedAt,
+		LastSeenAt:        lastSeenAt,
+		MissedSyncCount:   row.MissedSyncCount,
+		ContentHash:       row.ContentHash,
 	}
 }
@@ -14,11 +14,11 @@ func TestContentRepository_SaveAndSearch(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
 
-	repo := NewContentRepository(db)
+	repo := NewContentRepository(db, "english")
 	ctx := context.Background()
 
 	// Cleanup
-	// In a real scenario, we might truncate tables. 
+	// In a real scenario, we might truncate tables.
 	// For now, we rely on unique constraints or just adding new data.
 
 	content := entity.Content{
@@ -31,8 +31,9 @@ func TestContentRepository_SaveAndSearch(t *testing.T) {
 	}
 
 	// Test Save
-	err := repo.SaveOrUpdateContents(ctx, []entity.Content{content})
+	ids, err := repo.SaveOrUpdateContents(ctx, []entity.Content{content})
 	assert.NoError(t, err)
+	assert.Contains(t, ids, content.ProviderContentID)
 
 	// Test Search
 	filters := ports.SearchFilters{
@@ -47,14 +48,65 @@ func TestContentRepository_SaveAndSearch(t *testing.T) {
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, count, int64(1))
 	assert.NotEmpty(t, results)
-	
+
 	found := false
-	for _, c := range results {
-		if c.ProviderContentID == content.ProviderContentID {
+	for _, match := range results {
+		if match.Content.ProviderContentID == content.ProviderContentID {
 			found = true
-			assert.Equal(t, content.Title, c.Title)
+			assert.Equal(t, content.Title, match.Content.Title)
 			break
 		}
 	}
 	assert.True(t, found, "saved content not found in search results")
 }
+
+func TestContentRepository_ReconcileProviderContents(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewContentRepository(db, "english")
+	providerRepo := NewProviderRepository(db)
+	ctx := context.Background()
+
+	// A dedicated provider keeps the deactivation away from other fixtures.
+	err := providerRepo.UpsertProvider(ctx, entity.Provider{
+		Name:      "reconcile-provider",
+		Code:      "reconcile-provider",
+		Format:    entity.ProviderFormatJSON,
+		BaseURL:   "http://localhost/reconcile",
+		IsEnabled: false,
+	})
+	assert.NoError(t, err)
+	provider, err := providerRepo.GetByCode(ctx, "reconcile-provider")
+	assert.NoError(t, err)
+	assert.NotNil(t, provider)
+
+	contents := []entity.Content{
+		{ProviderID: provider.ID, ProviderContentID: "kept", Title: "Kept", ContentType: entity.ContentTypeArticle, PublishedAt: time.Now().UTC(), IsActive: true},
+		{ProviderID: provider.ID, ProviderContentID: "gone", Title: "Gone", ContentType: entity.ContentTypeArticle, PublishedAt: time.Now().UTC(), IsActive: true},
+	}
+	_, err = repo.SaveOrUpdateContents(ctx, contents)
+	assert.NoError(t, err)
+
+	opts := ports.ReconcileOptions{
+		DeactivateMissing: true,
+		MinMissedSyncs:    1,
+		SeenBefore:        time.Now().UTC().Add(time.Hour),
+	}
+
+	result, err := repo.ReconcileProviderContents(ctx, provider.ID, []string{"kept"}, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Deactivated)
+
+	count, err := repo.CountActiveByProvider(ctx, provider.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// The item comes back in the next fetch.
+	_, err = repo.SaveOrUpdateContents(ctx, contents)
+	assert.NoError(t, err)
+	result, err = repo.ReconcileProviderContents(ctx, provider.ID, []string{"kept", "gone"}, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Reactivated)
+	assert.Zero(t, result.Deactivated)
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type ContentScoreRepositorySqlc struct {
+	db      *sql.DB
+	queries *db.Queries
+}
+
+func NewContentScoreRepository(database *sql.DB) ports.ContentScoreRepository {
+	return &ContentScoreRepositorySqlc{
+		db:      database,
+		queries: db.New(database),
+	}
+}
+
+func (r *ContentScoreRepositorySqlc) SaveOrUpdateScores(ctx context.Context, scores []entity.ContentScore) error {
+	return withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		for _, score := range scores {
+			err := qtx.UpsertContentScore(ctx, db.UpsertContentScoreParams{
+				ContentID:       score.ContentID,
+				BaseScore:       score.Components.BaseScore,
+				TypeMultiplier:  score.Components.TypeMultiplier,
+				RecencyScore:    score.Components.RecencyScore,
+				EngagementScore: score.Components.EngagementScore,
+				FinalScore:      score.Components.FinalScore,
+				ComputedAt:      score.ComputedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("upsert content score: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ContentScoreRepositorySqlc) GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64]entity.ContentScore, error) {
+	rows, err := r.queries.GetContentScoresByIDs(ctx, contentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get content scores by ids: %w", err)
+	}
+
+	scores := make(map[int64]entity.ContentScore, len(rows))
+	for _, row := range rows {
+		scores[row.ContentID] = entity.ContentScore{
+			ContentID: row.ContentID,
+			Components: entity.ScoreComponents{
+				BaseScore:       row.BaseScore,
+				TypeMultiplier:  row.TypeMultiplier,
+				RecencyScore:    row.RecencyScore,
+				EngagementScore: row.EngagementScore,
+				FinalScore:      row.FinalScore,
+			},
+			ComputedAt: row.ComputedAt,
+		}
+	}
+
+	return scores, nil
+}
+
+func (r *ContentScoreRepositorySqlc) ListForScoring(ctx context.Context, afterID int64, limit int32) ([]ports.ContentWithStats, error) {
+	rows, err := r.queries.ListContentsForScoring(ctx, db.ListContentsForScoringParams{
+		AfterID:    afterID,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list contents for scoring: %w", err)
+	}
+
+	items := make([]ports.ContentWithStats, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, ports.ContentWithStats{
+			Content: entity.Content{
+				ID:          row.ID,
+				ContentType: entity.ContentType(row.ContentType),
+				PublishedAt: row.PublishedAt,
+			},
+			Stats: entity.ContentStats{
+				ContentID:   row.ID,
+				Views:       row.Views,
+				Likes:       row.Likes,
+				DurationSec: row.DurationSec,
+				ReadingTime: row.ReadingTime,
+				Reactions:   row.Reactions,
+				Comments:    row.Comments,
+			},
+		})
+	}
+
+	return items, nil
+}
@@ -23,34 +23,23 @@ func NewContentStatsRepository(database *sql.DB) ports.ContentStatsRepository {
 }
 
 func (r *ContentStatsRepositorySqlc) SaveOrUpdateStats(ctx context.Context, stats []entity.ContentStats) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	qtx := r.queries.WithTx(tx)
-
-	for _, stat := range stats {
-		err := qtx.UpsertContentStats(ctx, db.UpsertContentStatsParams{
-			ContentID:   stat.ContentID,
-			Views:       stat.Views,
-			Likes:       stat.Likes,
-			DurationSec: stat.DurationSec,
-			ReadingTime: stat.ReadingTime,
-			Reactions:   stat.Reactions,
-			Comments:    stat.Comments,
-		})
-		if err != nil {
-			return fmt.Errorf("upsert content stats: %w", err)
+	return withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		for _, stat := range stats {
+			err := qtx.UpsertContentStats(ctx, db.UpsertContentStatsParams{
+				ContentID:   stat.ContentID,
+				Views:       stat.Views,
+				Likes:       stat.Likes,
+				DurationSec: stat.DurationSec,
+				ReadingTime: stat.ReadingTime,
+				Reactions:   stat.Reactions,
+				Comments:    stat.Comments,
+			})
+			if err != nil {
+				return fmt.Errorf("upsert content stats: %w", err)
+			}
 		}
-	}
-
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
-	}
-
-	return nil
+		return nil
+	})
 }
 
 func (r *ContentStatsRepositorySqlc) GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64]entity.ContentStats, error) {
@@ -14,11 +14,9 @@ func TestContentStatsRepository_SaveAndGet(t *testing.T) {
 	defer db.Close()
 
 	// We need a content to attach stats to.
-	// Assuming content with ID 1 exists or we create one.
-	// Ideally, we should create a content first.
-	contentRepo := NewContentRepository(db)
+	contentRepo := NewContentRepository(db, "english")
 	ctx := context.Background()
-	
+
 	content := entity.Content{
 		ProviderID:        1,
 		ProviderContentID: "stats-test-1",
@@ -27,46 +25,25 @@ func TestContentStatsRepository_SaveAndGet(t *testing.T) {
 		PublishedAt:       time.Now().UTC(),
 		IsActive:          true,
 	}
-	err := contentRepo.SaveOrUpdateContents(ctx, []entity.Content{content})
+	ids, err := contentRepo.SaveOrUpdateContents(ctx, []entity.Content{content})
 	assert.NoError(t, err)
-	
-	// Get the ID
-	// This is a bit hacky without a dedicated GetByProviderID, but Search works
-	// Or we assume the DB is clean.
-	// Let's use Search to find it.
-	// (Skipping complexity for brevity, assuming we can find it)
-	// Actually, let's just try to insert stats for a likely ID or fetch it.
-	
-	// Better: Fetch the content we just saved
-	// ... implementation detail: depends on Search working correctly.
-	
-	// Let's assume we can get it back.
-	// For now, I will skip the setup complexity and just write the test structure.
-	// In a real integration test, we would have helpers to create fixtures.
-	
-	// Skipping actual execution logic that depends on data state, 
-	// but providing the test code structure.
-	
+	contentID := ids[content.ProviderContentID]
+	assert.NotZero(t, contentID)
+
 	repo := NewContentStatsRepository(db)
-	
+
 	stats := entity.ContentStats{
-		ContentID:   1, // This might fail if ID 1 doesn't exist
+		ContentID:   contentID,
 		Views:       100,
 		Likes:       10,
 		ReadingTime: 60,
 	}
-	
-	// This might fail foreign key constraint if content 1 doesn't exist.
-	// So we really should create content first.
-	// I'll leave it as is, noting that it requires a seeded DB or proper setup.
-	
+
 	err = repo.SaveOrUpdateStats(ctx, []entity.ContentStats{stats})
-	// assert.NoError(t, err) // Commented out as it might fail without ID 1
-	
-	if err == nil {
-		fetched, err := repo.GetByContentID(ctx, 1)
-		assert.NoError(t, err)
-		assert.NotNil(t, fetched)
-		assert.Equal(t, int64(100), fetched.Views)
-	}
+	assert.NoError(t, err)
+
+	fetched, err := repo.GetByContentID(ctx, contentID)
+	assert.NoError(t, err)
+	assert.NotNil(t, fetched)
+	assert.Equal(t, int64(100), fetched.Views)
 }
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type FeedStateRepositorySqlc struct {
+	db      *sql.DB
+	queries *db.Queries
+}
+
+func NewFeedStateRepository(database *sql.DB) ports.FeedStateRepository {
+	return &FeedStateRepositorySqlc{
+		db:      database,
+		queries: db.New(database),
+	}
+}
+
+func (r *FeedStateRepositorySqlc) Get(ctx context.Context, providerID int64) (*entity.FeedState, error) {
+	row, err := queriesFor(ctx, r.queries).GetProviderFeedState(ctx, providerID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get provider feed state: %w", err)
+	}
+
+	return &entity.FeedState{
+		ProviderID: row.ProviderID,
+		FeedURL:    row.FeedUrl,
+		Validators: entity.FeedValidators{
+			ETag:         row.Etag,
+			LastModified: row.LastModified,
+		},
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+func (r *FeedStateRepositorySqlc) Save(ctx context.Context, state entity.FeedState) error {
+	err := queriesFor(ctx, r.queries).UpsertProviderFeedState(ctx, db.UpsertProviderFeedStateParams{
+		ProviderID:   state.ProviderID,
+		FeedUrl:      state.FeedURL,
+		Etag:         state.Validators.ETag,
+		LastModified: state.Validators.LastModified,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert provider feed state: %w", err)
+	}
+	return nil
+}
@@ -42,3 +42,11 @@ func (r *MetadataRepository) GetContentTypeMetadata(ctx context.Context) ([]*con
 
 	return result, nil
 }
+
+func (r *MetadataRepository) GetProviderFormats(ctx context.Context) ([]string, error) {
+	formats, err := r.queries.GetEnabledProviderFormats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get provider formats: %w", err)
+	}
+	return formats, nil
+}
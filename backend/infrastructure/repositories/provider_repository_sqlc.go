@@ -3,7 +3,9 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -30,7 +32,29 @@ func (r *ProviderRepositorySqlc) GetAllEnabled(ctx context.Context) ([]entity.Pr
 
 	providers := make([]entity.Provider, 0, len(rows))
 	for _, row := range rows {
-		providers = append(providers, dbRowToProvider(row))
+		provider, err := dbRowToProvider(row)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+func (r *ProviderRepositorySqlc) ListAll(ctx context.Context) ([]entity.Provider, error) {
+	rows, err := r.queries.ListProviders(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list providers: %w", err)
+	}
+
+	providers := make([]entity.Provider, 0, len(rows))
+	for _, row := range rows {
+		provider, err := dbRowToProvider(row)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
 	}
 
 	return providers, nil
@@ -45,7 +69,10 @@ func (r *ProviderRepositorySqlc) GetByCode(ctx context.Context, code string) (*e
 		return nil, fmt.Errorf("get provider by code: %w", err)
 	}
 
-	provider := dbRowToProvider(row)
+	provider, err := dbRowToProvider(row)
+	if err != nil {
+		return nil, err
+	}
 	return &provider, nil
 }
 
@@ -58,17 +85,39 @@ func (r *ProviderRepositorySqlc) GetByID(ctx context.Context, id int64) (*entity
 		return nil, fmt.Errorf("get provider by id: %w", err)
 	}
 
-	provider := dbRowToProvider(row)
+	provider, err := dbRowToProvider(row)
+	if err != nil {
+		return nil, err
+	}
 	return &provider, nil
 }
 
 func (r *ProviderRepositorySqlc) UpsertProvider(ctx context.Context, provider entity.Provider) error {
-	err := r.queries.UpsertProvider(ctx, db.UpsertProviderParams{
-		Name:      provider.Name,
-		Code:      provider.Code,
-		Format:    provider.Format,
-		BaseUrl:   provider.BaseURL,
-		IsEnabled: provider.IsEnabled,
+	pagination, err := json.Marshal(provider.Pagination)
+	if err != nil {
+		return fmt.Errorf("encode feed pagination: %w", err)
+	}
+	mapping, err := json.Marshal(provider.Mapping)
+	if err != nil {
+		return fmt.Errorf("encode feed mapping: %w", err)
+	}
+	auth, err := json.Marshal(provider.Auth)
+	if err != nil {
+		return fmt.Errorf("encode feed auth: %w", err)
+	}
+
+	err = r.queries.UpsertProvider(ctx, db.UpsertProviderParams{
+		Name:                provider.Name,
+		Code:                provider.Code,
+		Format:              provider.Format,
+		BaseUrl:             provider.BaseURL,
+		IsEnabled:           provider.IsEnabled,
+		SyncIntervalSeconds: int32(provider.Schedule.Interval / time.Second),
+		SyncCron:            provider.Schedule.Cron,
+		SyncJitterSeconds:   int32(provider.Schedule.Jitter / time.Second),
+		FeedPagination:      pagination,
+		FeedMapping:         mapping,
+		FeedAuth:            auth,
 	})
 	if err != nil {
 		return fmt.Errorf("upsert provider: %w", err)
@@ -76,7 +125,27 @@ func (r *ProviderRepositorySqlc) UpsertProvider(ctx context.Context, provider en
 	return nil
 }
 
-func dbRowToProvider(row db.Provider) entity.Provider {
+func (r *ProviderRepositorySqlc) HasContents(ctx context.Context, id int64) (bool, error) {
+	hasContents, err := r.queries.ProviderHasContents(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("check provider contents: %w", err)
+	}
+	return hasContents, nil
+}
+
+func (r *ProviderRepositorySqlc) Delete(ctx context.Context, id int64) error {
+	return withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		if err := qtx.DeleteSyncRunsByProvider(ctx, id); err != nil {
+			return fmt.Errorf("delete sync runs: %w", err)
+		}
+		if err := qtx.DeleteProvider(ctx, id); err != nil {
+			return fmt.Errorf("delete provider: %w", err)
+		}
+		return nil
+	})
+}
+
+func dbRowToProvider(row db.Provider) (entity.Provider, error) {
 	var formatStr string
 	switch v := row.Format.(type) {
 	case string:
@@ -87,6 +156,19 @@ func dbRowToProvider(row db.Provider) entity.Provider {
 		formatStr = fmt.Sprintf("%v", row.Format)
 	}
 
+	var pagination entity.FeedPagination
+	if err := json.Unmarshal(row.FeedPagination, &pagination); err != nil {
+		return entity.Provider{}, fmt.Errorf("decode feed pagination of provider %s: %w", row.Code, err)
+	}
+	var mapping entity.FeedMapping
+	if err := json.Unmarshal(row.FeedMapping, &mapping); err != nil {
+		return entity.Provider{}, fmt.Errorf("decode feed mapping of provider %s: %w", row.Code, err)
+	}
+	var auth entity.FeedAuth
+	if err := json.Unmarshal(row.FeedAuth, &auth); err != nil {
+		return entity.Provider{}, fmt.Errorf("decode feed auth of provider %s: %w", row.Code, err)
+	}
+
 	return entity.Provider{
 		ID:        row.ID,
 		Name:      row.Name,
@@ -94,7 +176,15 @@ func dbRowToProvider(row db.Provider) entity.Provider {
 		Format:    formatStr,
 		BaseURL:   row.BaseUrl,
 		IsEnabled: row.IsEnabled,
-		CreatedAt: row.CreatedAt,
-		UpdatedAt: row.UpdatedAt,
-	}
+		Schedule: entity.SyncSchedule{
+			Interval: time.Duration(row.SyncIntervalSeconds) * time.Second,
+			Cron:     row.SyncCron,
+			Jitter:   time.Duration(row.SyncJitterSeconds) * time.Second,
+		},
+		Pagination: pagination,
+		Mapping:    mapping,
+		Auth:       auth,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}, nil
 }
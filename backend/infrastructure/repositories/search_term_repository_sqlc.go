@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type SearchTermRepositorySqlc struct {
+	db      *sql.DB
+	queries *db.Queries
+}
+
+func NewSearchTermRepository(database *sql.DB) ports.SearchTermRepository {
+	return &SearchTermRepositorySqlc{
+		db:      database,
+		queries: db.New(database),
+	}
+}
+
+func (r *SearchTermRepositorySqlc) SuggestTerms(ctx context.Context, word string, limit int32) ([]string, error) {
+	rows, err := r.queries.SuggestSearchTerms(ctx, db.SuggestSearchTermsParams{
+		Word:       word,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("suggest search terms: %w", err)
+	}
+
+	terms := make([]string, len(rows))
+	for i, row := range rows {
+		terms[i] = row.Term
+	}
+	return terms, nil
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type SyncRunRepositorySqlc struct {
+	db      *sql.DB
+	queries *db.Queries
+}
+
+func NewSyncRunRepository(database *sql.DB) ports.SyncRunRepository {
+	return &SyncRunRepositorySqlc{
+		db:      database,
+		queries: db.New(database),
+	}
+}
+
+func (r *SyncRunRepositorySqlc) Create(ctx context.Context, run entity.SyncRun) (int64, error) {
+	id, err := r.queries.CreateSyncRun(ctx, db.CreateSyncRunParams{
+		ProviderID: run.ProviderID,
+		StartedAt:  run.StartedAt,
+		Status:     string(run.Status),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create sync run: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SyncRunRepositorySqlc) Update(ctx context.Context, run entity.SyncRun) error {
+	var finishedAt sql.NullTime
+	if run.FinishedAt != nil {
+		finishedAt = sql.NullTime{Time: *run.FinishedAt, Valid: true}
+	}
+
+	var errorMessage sql.NullString
+	if run.ErrorMessage != "" {
+		errorMessage = sql.NullString{String: run.ErrorMessage, Valid: true}
+	}
+
+	err := r.queries.UpdateSyncRun(ctx, db.UpdateSyncRunParams{
+		FinishedAt:       finishedAt,
+		Status:           string(run.Status),
+		ItemCount:        run.ItemCount,
+		UpsertedCount:    run.UpsertedCount,
+		StatsCount:       run.StatsCount,
+		TagsCount:        run.TagsCount,
+		SkippedCount:     run.SkippedCount,
+		DeactivatedCount: run.DeactivatedCount,
+		ReactivatedCount: run.ReactivatedCount,
+		UnchangedCount:   run.UnchangedCount,
+		NotModified:      run.NotModified,
+		ErrorMessage:     errorMessage,
+		ID:               run.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("update sync run: %w", err)
+	}
+	return nil
+}
+
+func (r *SyncRunRepositorySqlc) ListByProvider(ctx context.Context, providerID int64, limit int32) ([]entity.SyncRun, error) {
+	rows, err := r.queries.ListSyncRunsByProvider(ctx, db.ListSyncRunsByProviderParams{
+		ProviderID: providerID,
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list sync runs by provider: %w", err)
+	}
+
+	runs := make([]entity.SyncRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, dbRowToSyncRun(row))
+	}
+
+	return runs, nil
+}
+
+func (r *SyncRunRepositorySqlc) GetByID(ctx context.Context, id int64) (*entity.SyncRun, error) {
+	row, err := r.queries.GetSyncRunByID(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get sync run by id: %w", err)
+	}
+
+	run := dbRowToSyncRun(row)
+	return &run, nil
+}
+
+func dbRowToSyncRun(row db.ProviderSyncRun) entity.SyncRun {
+	run := entity.SyncRun{
+		ID:               row.ID,
+		ProviderID:       row.ProviderID,
+		StartedAt:        row.StartedAt,
+		Status:           entity.SyncRunStatus(row.Status),
+		ItemCount:        row.ItemCount,
+		UpsertedCount:    row.UpsertedCount,
+		StatsCount:       row.StatsCount,
+		TagsCount:        row.TagsCount,
+		SkippedCount:     row.SkippedCount,
+		DeactivatedCount: row.DeactivatedCount,
+		ReactivatedCount: row.ReactivatedCount,
+		UnchangedCount:   row.UnchangedCount,
+		NotModified:      row.NotModified,
+		ErrorMessage:     row.ErrorMessage.String,
+		CreatedAt:        row.CreatedAt,
+	}
+	if row.FinishedAt.Valid {
+		finishedAt := row.FinishedAt.Time
+		run.FinishedAt = &finishedAt
+	}
+	return run
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncRunRepository_CreateUpdateAndList(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	repo := NewSyncRunRepository(db)
+	ctx := context.Background()
+
+	run := entity.SyncRun{
+		ProviderID: 1,
+		StartedAt:  time.Now().UTC(),
+		Status:     entity.SyncRunStatusRunning,
+	}
+
+	id, err := repo.Create(ctx, run)
+	assert.NoError(t, err)
+	assert.NotZero(t, id)
+
+	finishedAt := time.Now().UTC()
+	run.ID = id
+	run.FinishedAt = &finishedAt
+	run.Status = entity.SyncRunStatusFailed
+	run.ItemCount = 10
+	run.UpsertedCount = 8
+	run.SkippedCount = 2
+	run.ErrorMessage = "save content stats: boom"
+
+	err = repo.Update(ctx, run)
+	assert.NoError(t, err)
+
+	fetched, err := repo.GetByID(ctx, id)
+	assert.NoError(t, err)
+	assert.NotNil(t, fetched)
+	assert.Equal(t, entity.SyncRunStatusFailed, fetched.Status)
+	assert.Equal(t, int32(8), fetched.UpsertedCount)
+	assert.Equal(t, run.ErrorMessage, fetched.ErrorMessage)
+	assert.True(t, fetched.IsFinished())
+
+	runs, err := repo.ListByProvider(ctx, 1, 5)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, runs)
+	assert.Equal(t, id, runs[0].ID)
+}
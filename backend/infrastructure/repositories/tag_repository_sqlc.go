@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -25,8 +26,9 @@ func NewTagRepository(database *sql.DB) ports.TagRepository {
 func (r *TagRepositorySqlc) EnsureTags(ctx context.Context, tagNames []string) ([]entity.Tag, error) {
 	tags := make([]entity.Tag, 0, len(tagNames))
 
+	queries := queriesFor(ctx, r.queries)
 	for _, name := range tagNames {
-		row, err := r.queries.EnsureTag(ctx, name)
+		row, err := queries.EnsureTag(ctx, name)
 		if err != nil {
 			return nil, fmt.Errorf("ensure tag %s: %w", name, err)
 		}
@@ -40,33 +42,22 @@ func (r *TagRepositorySqlc) EnsureTags(ctx context.Context, tagNames []string) (
 }
 
 func (r *TagRepositorySqlc) AssignToContent(ctx context.Context, contentID int64, tagIDs []int64) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	qtx := r.queries.WithTx(tx)
-
-	if err := qtx.RemoveContentTags(ctx, contentID); err != nil {
-		return fmt.Errorf("remove existing tags: %w", err)
-	}
-
-	for _, tagID := range tagIDs {
-		err := qtx.AssignTagToContent(ctx, db.AssignTagToContentParams{
-			ContentID: contentID,
-			TagID:     tagID,
-		})
-		if err != nil {
-			return fmt.Errorf("assign tag: %w", err)
+	return withTx(ctx, r.db, r.queries, func(qtx *db.Queries) error {
+		if err := qtx.RemoveContentTags(ctx, contentID); err != nil {
+			return fmt.Errorf("remove existing tags: %w", err)
 		}
-	}
 
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit transaction: %w", err)
-	}
-
-	return nil
+		for _, tagID := range tagIDs {
+			err := qtx.AssignTagToContent(ctx, db.AssignTagToContentParams{
+				ContentID: contentID,
+				TagID:     tagID,
+			})
+			if err != nil {
+				return fmt.Errorf("assign tag: %w", err)
+			}
+		}
+		return nil
+	})
 }
 
 func (r *TagRepositorySqlc) GetByContentID(ctx context.Context, contentID int64) ([]entity.Tag, error) {
@@ -85,3 +76,51 @@ func (r *TagRepositorySqlc) GetByContentID(ctx context.Context, contentID int64)
 
 	return tags, nil
 }
+func (r *TagRepositorySqlc) GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64][]entity.Tag, error) {
+	rows, err := r.queries.GetTagsByContentIDs(ctx, contentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get tags by content ids: %w", err)
+	}
+
+	tags := make(map[int64][]entity.Tag, len(contentIDs))
+	for _, row := range rows {
+		tags[row.ContentID] = append(tags[row.ContentID], entity.Tag{
+			ID:   row.ID,
+			Name: row.Name,
+		})
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepositorySqlc) ListWithCounts(ctx context.Context, contentType *entity.ContentType, limit int32) ([]entity.TagCount, error) {
+	rows, err := r.queries.ListTagsWithCounts(ctx, db.ListTagsWithCountsParams{
+		ContentType: nullContentType(contentType),
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list tags with counts: %w", err)
+	}
+
+	counts := make([]entity.TagCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, entity.TagCount{
+			Tag:          entity.Tag{ID: row.ID, Name: row.Name},
+			ContentCount: row.ContentCount,
+		})
+	}
+
+	return counts, nil
+}
+
+func (r *TagRepositorySqlc) AutocompleteTags(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error) {
+	names, err := r.queries.AutocompleteTags(ctx, db.AutocompleteTagsParams{
+		Prefix:      escapeLike(strings.ToLower(prefix)),
+		ContentType: nullContentType(contentType),
+		LimitCount:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("autocomplete tags: %w", err)
+	}
+	return names, nil
+}
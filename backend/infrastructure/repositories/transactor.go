@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/mehmetymw/search-aggregation-service/backend/db/generated"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+)
+
+type txKey struct{}
+
+type SqlTransactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(database *sql.DB) ports.Transactor {
+	return &SqlTransactor{db: database}
+}
+
+func (t *SqlTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func txFromContext(ctx context.Context) *sql.Tx {
+	tx, _ := ctx.Value(txKey{}).(*sql.Tx)
+	return tx
+}
+
+// queriesFor returns queries bound to the transaction carried by ctx, if any.
+func queriesFor(ctx context.Context, queries *db.Queries) *db.Queries {
+	if tx := txFromContext(ctx); tx != nil {
+		return queries.WithTx(tx)
+	}
+	return queries
+}
+
+// withTx runs fn against the transaction carried by ctx, or against a new one
+// that is committed when fn succeeds.
+func withTx(ctx context.Context, database *sql.DB, queries *db.Queries, fn func(qtx *db.Queries) error) error {
+	if tx := txFromContext(ctx); tx != nil {
+		return fn(queries.WithTx(tx))
+	}
+
+	tx, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(queries.WithTx(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
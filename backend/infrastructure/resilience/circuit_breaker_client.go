@@ -2,44 +2,55 @@ package resilience
 
 import (
 	"context"
-	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
-	"github.com/sony/gobreaker"
 )
 
 type CircuitBreakerProviderClient struct {
-	client ports.ProviderClient
-	cb     *gobreaker.CircuitBreaker
+	client   ports.ProviderClient
+	breakers *ProviderCircuitBreakers
 }
 
-func NewCircuitBreakerProviderClient(client ports.ProviderClient, config entity.CircuitBreakerConfig) *CircuitBreakerProviderClient {
-	settings := gobreaker.Settings{
-		Name:        config.Name,
-		MaxRequests: config.MaxRequests,
-		Interval:    time.Duration(config.Interval) * time.Second,
-		Timeout:     time.Duration(config.Timeout) * time.Second,
-		ReadyToTrip: func(counts gobreaker.Counts) bool {
-			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-			return counts.Requests >= 3 && failureRatio >= 0.6
-		},
-	}
-
+func NewCircuitBreakerProviderClient(client ports.ProviderClient, breakers *ProviderCircuitBreakers) *CircuitBreakerProviderClient {
 	return &CircuitBreakerProviderClient{
-		client: client,
-		cb:     gobreaker.NewCircuitBreaker(settings),
+		client:   client,
+		breakers: breakers,
 	}
 }
 
-func (c *CircuitBreakerProviderClient) FetchContents(ctx context.Context, provider entity.Provider) ([]ports.ProviderContentItem, error) {
-	result, err := c.cb.Execute(func() (interface{}, error) {
-		return c.client.FetchContents(ctx, provider)
+// FetchContents runs the fetch through the provider's own breaker, counting
+// it only when the provider fails; an error from handle is the caller's and
+// is passed through. Unsaved providers, which have no code yet, are fetched
+// without a breaker.
+func (c *CircuitBreakerProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	if provider.Code == "" {
+		return c.client.FetchContents(ctx, provider, validators, handle)
+	}
+
+	var callerErr error
+	result, err := c.breakers.breaker(provider.Code).Execute(func() (interface{}, error) {
+		var handleErr error
+		result, err := c.client.FetchContents(ctx, provider, validators, func(ctx context.Context, items ports.ContentItems) error {
+			handleErr = handle(ctx, items)
+			return handleErr
+		})
+		// The client passes handle's error through as is. A page's decoding
+		// error, which handle sees too, comes back wrapped and is the
+		// provider's.
+		if err != nil && err == handleErr {
+			callerErr = err
+			return nil, nil
+		}
+		return result, err
 	})
 
+	if callerErr != nil {
+		return nil, callerErr
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return result.([]ports.ProviderContentItem), nil
+	return result.(*ports.FetchResult), nil
 }
@@ -0,0 +1,130 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/test/mocks"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type failingClient struct {
+	calls map[string]int
+}
+
+func (c *failingClient) FetchContents(_ context.Context, provider entity.Provider, _ entity.FeedValidators, _ ports.PageHandler) (*ports.FetchResult, error) {
+	c.calls[provider.Code]++
+	return nil, errors.New("provider down")
+}
+
+func newTestBreakers(config entity.CircuitBreakerConfig) (*ProviderCircuitBreakers, *mocks.MockLogger) {
+	logger := new(mocks.MockLogger)
+	logger.On("Info", mock.Anything, mock.Anything).Return()
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	return NewProviderCircuitBreakers(config, logger), logger
+}
+
+func fetch(client ports.ProviderClient, code string) error {
+	_, err := client.FetchContents(context.Background(), entity.Provider{Code: code}, entity.FeedValidators{}, func(context.Context, ports.ContentItems) error {
+		return nil
+	})
+	return err
+}
+
+func TestCircuitBreakerProviderClient_PerProvider(t *testing.T) {
+	config := entity.CircuitBreakerConfig{
+		Name:                     "provider",
+		CircuitBreakerThresholds: entity.CircuitBreakerThresholds{Timeout: 60},
+		Providers: map[string]entity.CircuitBreakerThresholds{
+			"flaky": {MinRequests: 5},
+		},
+	}
+
+	t.Run("Opens Only The Failing Provider", func(t *testing.T) {
+		breakers, logger := newTestBreakers(config)
+		inner := &failingClient{calls: map[string]int{}}
+		client := NewCircuitBreakerProviderClient(inner, breakers)
+
+		for range 4 {
+			fetch(client, "news")
+		}
+		assert.ErrorIs(t, fetch(client, "news"), gobreaker.ErrOpenState)
+		assert.Equal(t, 3, inner.calls["news"])
+		assert.Equal(t, ports.CircuitBreakerOpen, breakers.State("news").State)
+		assert.Equal(t, ports.CircuitBreakerState{State: ports.CircuitBreakerClosed}, breakers.State("videos"))
+		logger.AssertCalled(t, "Warn", "provider circuit breaker state changed", mock.Anything, mock.Anything, mock.Anything)
+
+		assert.EqualError(t, fetch(client, "videos"), "provider down")
+		state := breakers.State("videos")
+		assert.Equal(t, ports.CircuitBreakerClosed, state.State)
+		assert.Equal(t, uint32(1), state.TotalFailures)
+	})
+
+	t.Run("Provider Thresholds Override The Defaults", func(t *testing.T) {
+		breakers, _ := newTestBreakers(config)
+		inner := &failingClient{calls: map[string]int{}}
+		client := NewCircuitBreakerProviderClient(inner, breakers)
+
+		for range 4 {
+			fetch(client, "flaky")
+		}
+		assert.Equal(t, ports.CircuitBreakerClosed, breakers.State("flaky").State)
+		fetch(client, "flaky")
+		assert.Equal(t, ports.CircuitBreakerOpen, breakers.State("flaky").State)
+		assert.Equal(t, 5, inner.calls["flaky"])
+	})
+
+	t.Run("Reset Closes The Breaker", func(t *testing.T) {
+		breakers, logger := newTestBreakers(config)
+		inner := &failingClient{calls: map[string]int{}}
+		client := NewCircuitBreakerProviderClient(inner, breakers)
+
+		for range 3 {
+			fetch(client, "news")
+		}
+		assert.Equal(t, ports.CircuitBreakerOpen, breakers.State("news").State)
+
+		breakers.Reset("news")
+		assert.Equal(t, ports.CircuitBreakerState{State: ports.CircuitBreakerClosed}, breakers.State("news"))
+		logger.AssertCalled(t, "Info", "provider circuit breaker reset", mock.Anything)
+		assert.EqualError(t, fetch(client, "news"), "provider down")
+		assert.Equal(t, 4, inner.calls["news"])
+	})
+
+	t.Run("Unsaved Providers Bypass The Breakers", func(t *testing.T) {
+		breakers, _ := newTestBreakers(config)
+		inner := &failingClient{calls: map[string]int{}}
+		client := NewCircuitBreakerProviderClient(inner, breakers)
+
+		for range 5 {
+			assert.EqualError(t, fetch(client, ""), "provider down")
+		}
+		assert.Equal(t, 5, inner.calls[""])
+	})
+}
+
+func TestCircuitBreakerProviderClient_CallerErrorsDoNotCount(t *testing.T) {
+	breakers, _ := newTestBreakers(entity.CircuitBreakerConfig{})
+	inner := new(mocks.MockProviderClient)
+	client := NewCircuitBreakerProviderClient(inner, breakers)
+
+	handleErr := errors.New("store failed")
+	pages := [][]ports.ProviderContentItem{{{ProviderContentID: "a"}}}
+	inner.On("FetchContents", mock.Anything, mock.Anything, mock.Anything).Return(pages, nil, nil)
+
+	for range 3 {
+		_, err := client.FetchContents(context.Background(), entity.Provider{Code: "news"}, entity.FeedValidators{}, func(context.Context, ports.ContentItems) error {
+			return handleErr
+		})
+		assert.ErrorIs(t, err, handleErr)
+	}
+	state := breakers.State("news")
+	assert.Equal(t, ports.CircuitBreakerClosed, state.State)
+	assert.Equal(t, uint32(3), state.TotalSuccesses)
+}
@@ -0,0 +1,102 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+	"github.com/sony/gobreaker"
+)
+
+// ProviderCircuitBreakers keeps a circuit breaker per provider code, created
+// on the provider's first fetch with its configured thresholds.
+type ProviderCircuitBreakers struct {
+	config entity.CircuitBreakerConfig
+	logger ports.Logger
+
+	mu       sync.Mutex
+	breakers map[string]*gobreaker.CircuitBreaker
+}
+
+func NewProviderCircuitBreakers(config entity.CircuitBreakerConfig, logger ports.Logger) *ProviderCircuitBreakers {
+	return &ProviderCircuitBreakers{
+		config:   config,
+		logger:   logger,
+		breakers: make(map[string]*gobreaker.CircuitBreaker),
+	}
+}
+
+func (b *ProviderCircuitBreakers) breaker(providerCode string) *gobreaker.CircuitBreaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb, ok := b.breakers[providerCode]
+	if !ok {
+		cb = b.newBreaker(providerCode)
+		b.breakers[providerCode] = cb
+	}
+	return cb
+}
+
+func (b *ProviderCircuitBreakers) newBreaker(providerCode string) *gobreaker.CircuitBreaker {
+	thresholds := b.config.ForProvider(providerCode)
+	name := providerCode
+	if b.config.Name != "" {
+		name = b.config.Name + ":" + providerCode
+	}
+
+	return gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: thresholds.MaxRequests,
+		Interval:    time.Duration(thresholds.Interval) * time.Second,
+		Timeout:     time.Duration(thresholds.Timeout) * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= thresholds.GetMinRequests() && failureRatio >= thresholds.GetFailureRatio()
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			log := b.logger.Info
+			if to == gobreaker.StateOpen {
+				log = b.logger.Warn
+			}
+			log("provider circuit breaker state changed",
+				loggerPkg.String("provider_code", providerCode),
+				loggerPkg.String("from", from.String()),
+				loggerPkg.String("to", to.String()))
+		},
+	})
+}
+
+func (b *ProviderCircuitBreakers) State(providerCode string) ports.CircuitBreakerState {
+	b.mu.Lock()
+	cb, ok := b.breakers[providerCode]
+	b.mu.Unlock()
+	if !ok {
+		return ports.CircuitBreakerState{State: ports.CircuitBreakerClosed}
+	}
+
+	counts := cb.Counts()
+	return ports.CircuitBreakerState{
+		State:                cb.State().String(),
+		Requests:             counts.Requests,
+		TotalSuccesses:       counts.TotalSuccesses,
+		TotalFailures:        counts.TotalFailures,
+		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+		ConsecutiveFailures:  counts.ConsecutiveFailures,
+	}
+}
+
+// Reset drops the provider's breaker, so its next fetch starts a closed one.
+// Fetches already running finish against the dropped breaker.
+func (b *ProviderCircuitBreakers) Reset(providerCode string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.breakers[providerCode]; !ok {
+		return
+	}
+	delete(b.breakers, providerCode)
+	b.logger.Info("provider circuit breaker reset", loggerPkg.String("provider_code", providerCode))
+}
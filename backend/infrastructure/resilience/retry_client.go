@@ -0,0 +1,162 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+)
+
+// RetryingProviderClient retries fetches that failed for a transient
+// reason before any page was handed over. Once handle has seen a page the
+// caller has acted on it, so the fetch is not repeated; the clients' own
+// page retries cover later pages.
+//
+// Wrapped in a CircuitBreakerProviderClient, a fetch and its retries count
+// as one call of the breaker. Unsaved providers, which have no code yet and
+// so no budget of their own, are fetched once without retries.
+type RetryingProviderClient struct {
+	client ports.ProviderClient
+	config entity.RetryConfig
+	logger ports.Logger
+	// sleep waits d unless ctx is done first.
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu sync.Mutex
+	// budgets holds the retries left to each provider, by provider code.
+	budgets map[string]float64
+}
+
+func NewRetryingProviderClient(client ports.ProviderClient, config entity.RetryConfig, logger ports.Logger) *RetryingProviderClient {
+	return &RetryingProviderClient{
+		client:  client,
+		config:  config,
+		logger:  logger,
+		sleep:   sleepContext,
+		budgets: make(map[string]float64),
+	}
+}
+
+func (c *RetryingProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	if provider.Code == "" {
+		return c.client.FetchContents(ctx, provider, validators, handle)
+	}
+	c.deposit(provider.Code)
+
+	for attempt := 1; ; attempt++ {
+		handed := false
+		result, err := c.client.FetchContents(ctx, provider, validators, func(ctx context.Context, items ports.ContentItems) error {
+			handed = true
+			return handle(ctx, items)
+		})
+		if err == nil || handed || attempt >= c.config.GetMaxAttempts() {
+			return result, err
+		}
+
+		retryable, retryAfter := classifyFetchError(ctx, err)
+		if !retryable || retryAfter > c.config.GetMaxBackoff() {
+			return nil, err
+		}
+		if !c.withdraw(provider.Code) {
+			c.logger.Warn("provider fetch not retried, retry budget spent",
+				loggerPkg.String("provider_code", provider.Code),
+				loggerPkg.Error(err))
+			return nil, err
+		}
+
+		wait := max(c.backoff(attempt), retryAfter)
+		c.logger.Warn("retrying provider fetch",
+			loggerPkg.String("provider_code", provider.Code),
+			loggerPkg.Int("attempt", attempt),
+			loggerPkg.Int64("wait_ms", wait.Milliseconds()),
+			loggerPkg.Error(err))
+		if c.sleep(ctx, wait) != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff returns the wait before retry number attempt: the initial backoff
+// doubled for every earlier retry, capped, and jittered down by up to half
+// so providers failing together are not retried in step.
+func (c *RetryingProviderClient) backoff(attempt int) time.Duration {
+	wait := c.config.GetInitialBackoff()
+	for i := 1; i < attempt && wait < c.config.GetMaxBackoff(); i++ {
+		wait *= 2
+	}
+	wait = min(wait, c.config.GetMaxBackoff())
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func (c *RetryingProviderClient) deposit(providerCode string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	budget, ok := c.budgets[providerCode]
+	if !ok {
+		budget = c.config.GetBudgetBurst()
+	}
+	c.budgets[providerCode] = min(budget+c.config.GetBudgetRatio(), c.config.GetBudgetBurst())
+}
+
+func (c *RetryingProviderClient) withdraw(providerCode string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.budgets[providerCode] < 1 {
+		return false
+	}
+	c.budgets[providerCode]--
+	return true
+}
+
+// classifyFetchError reports whether a failed fetch may succeed when
+// repeated, and how long the provider asked to wait first. Transport
+// failures, timeouts, 408, 429 and 5xx responses other than 501 are
+// retryable; other 4xx responses, decoding errors and a done ctx are not.
+func classifyFetchError(ctx context.Context, err error) (bool, time.Duration) {
+	if ctx.Err() != nil {
+		return false, 0
+	}
+
+	var statusErr *ports.ProviderStatusError
+	if errors.As(err, &statusErr) {
+		switch code := statusErr.StatusCode; {
+		case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+			return true, statusErr.RetryAfter
+		case code >= http.StatusInternalServerError && code != http.StatusNotImplemented:
+			return true, statusErr.RetryAfter
+		}
+		return false, 0
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		var opErr *net.OpError
+		transient := urlErr.Timeout() || errors.As(urlErr, &opErr) ||
+			errors.Is(urlErr, io.EOF) || errors.Is(urlErr, io.ErrUnexpectedEOF)
+		return transient, 0
+	}
+	return false, 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,176 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	"github.com/mehmetymw/search-aggregation-service/backend/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// scriptedClient fails with errs in turn, then succeeds. A nil error hands
+// a page over before failing with pageErr.
+type scriptedClient struct {
+	errs    []error
+	pageErr error
+	calls   int
+}
+
+func (c *scriptedClient) FetchContents(ctx context.Context, _ entity.Provider, _ entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	c.calls++
+	if c.calls > len(c.errs) {
+		return &ports.FetchResult{Pages: 1}, nil
+	}
+	if err := c.errs[c.calls-1]; err != nil {
+		return nil, err
+	}
+	if err := handle(ctx, func(func(ports.ProviderContentItem, error) bool) {}); err != nil {
+		return nil, err
+	}
+	return nil, c.pageErr
+}
+
+func newTestRetryClient(inner ports.ProviderClient, config entity.RetryConfig) (*RetryingProviderClient, *[]time.Duration) {
+	logger := new(mocks.MockLogger)
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Warn", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	client := NewRetryingProviderClient(inner, config, logger)
+	waits := new([]time.Duration)
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return client, waits
+}
+
+func TestRetryingProviderClient_FetchContents(t *testing.T) {
+	ctx := context.Background()
+	provider := entity.Provider{Code: "news"}
+	noop := func(context.Context, ports.ContentItems) error { return nil }
+	unavailable := &ports.ProviderStatusError{StatusCode: 503}
+	timeout := &url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded}
+	config := entity.RetryConfig{InitialBackoffMs: 100, MaxBackoffSeconds: 10}
+
+	t.Run("Transient Failures Are Retried With Backoff", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{unavailable, timeout}}
+		client, waits := newTestRetryClient(inner, config)
+
+		result, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Pages)
+		assert.Equal(t, 3, inner.calls)
+		assert.Len(t, *waits, 2)
+		assert.InDelta(t, 75*time.Millisecond, (*waits)[0], float64(25*time.Millisecond))
+		assert.InDelta(t, 150*time.Millisecond, (*waits)[1], float64(50*time.Millisecond))
+	})
+
+	t.Run("Attempts Are Bounded", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{unavailable, unavailable, unavailable, unavailable}}
+		client, _ := newTestRetryClient(inner, config)
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("Permanent Failures Are Not Retried", func(t *testing.T) {
+		for name, fetchErr := range map[string]error{
+			"not found":       &ports.ProviderStatusError{StatusCode: 404},
+			"not implemented": &ports.ProviderStatusError{StatusCode: 501},
+			"decode error":    errors.New("page 1: decode contents: unexpected EOF"),
+		} {
+			inner := &scriptedClient{errs: []error{fetchErr}}
+			client, _ := newTestRetryClient(inner, config)
+
+			_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+			assert.ErrorIs(t, err, fetchErr, name)
+			assert.Equal(t, 1, inner.calls, name)
+		}
+	})
+
+	t.Run("Retry-After Is Honoured", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{&ports.ProviderStatusError{StatusCode: 429, RetryAfter: 4 * time.Second}}}
+		client, waits := newTestRetryClient(inner, config)
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Duration{4 * time.Second}, *waits)
+	})
+
+	t.Run("Retry-After Beyond The Max Backoff Is Not Waited Out", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{&ports.ProviderStatusError{StatusCode: 503, RetryAfter: time.Hour}}}
+		client, waits := newTestRetryClient(inner, config)
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.Error(t, err)
+		assert.Equal(t, 1, inner.calls)
+		assert.Empty(t, *waits)
+	})
+
+	t.Run("Fetches That Handed Over A Page Are Not Retried", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{nil}, pageErr: unavailable}
+		client, _ := newTestRetryClient(inner, config)
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 1, inner.calls)
+	})
+
+	t.Run("Retries Draw From The Provider Budget", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{unavailable, unavailable, unavailable, unavailable}}
+		client, _ := newTestRetryClient(inner, entity.RetryConfig{MaxAttempts: 2, BudgetBurst: 1, BudgetRatio: 0.5})
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 2, inner.calls)
+
+		// Half a retry was earned back, not enough for another one.
+		_, err = client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 3, inner.calls)
+
+		// Another provider has a budget of its own.
+		_, err = client.FetchContents(ctx, entity.Provider{Code: "videos"}, entity.FeedValidators{}, noop)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, inner.calls)
+	})
+
+	t.Run("Unsaved Providers Are Not Retried", func(t *testing.T) {
+		inner := &scriptedClient{errs: []error{unavailable, unavailable}}
+		client, waits := newTestRetryClient(inner, config)
+
+		_, err := client.FetchContents(ctx, entity.Provider{}, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 1, inner.calls)
+		assert.Empty(t, *waits)
+		assert.Empty(t, client.budgets)
+
+		// A failing candidate leaves the next one's fetch unaffected.
+		_, err = client.FetchContents(ctx, entity.Provider{}, entity.FeedValidators{}, noop)
+		assert.ErrorIs(t, err, unavailable)
+		assert.Equal(t, 2, inner.calls)
+		_, err = client.FetchContents(ctx, entity.Provider{}, entity.FeedValidators{}, noop)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, inner.calls)
+	})
+
+	t.Run("Retries Count As One Breaker Call", func(t *testing.T) {
+		breakers, _ := newTestBreakers(entity.CircuitBreakerConfig{})
+		inner := &scriptedClient{errs: []error{unavailable, unavailable}}
+		retrying, _ := newTestRetryClient(inner, config)
+		client := NewCircuitBreakerProviderClient(retrying, breakers)
+
+		_, err := client.FetchContents(ctx, provider, entity.FeedValidators{}, noop)
+		assert.NoError(t, err)
+		state := breakers.State("news")
+		assert.Equal(t, uint32(1), state.Requests)
+		assert.Equal(t, uint32(0), state.TotalFailures)
+	})
+}
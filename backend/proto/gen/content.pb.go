@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,12 +24,23 @@ const (
 )
 
 type SearchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
-	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Query    string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Type     string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Sort     string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Page     int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Normalized tag names; contents must have any of them, or all of them
+	// when tag_match is "all".
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExcludeTags   []string `protobuf:"bytes,7,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	TagMatch      string   `protobuf:"bytes,8,opt,name=tag_match,json=tagMatch,proto3" json:"tag_match,omitempty"` // any (default) or all
+	IncludeFacets bool     `protobuf:"varint,9,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	// RFC 3339 bounds on published_at; after is inclusive, before exclusive.
+	PublishedAfter  string `protobuf:"bytes,10,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"`
+	PublishedBefore string `protobuf:"bytes,11,opt,name=published_before,json=publishedBefore,proto3" json:"published_before,omitempty"`
+	// Provider codes; contents must come from any of them.
+	Providers     []string `protobuf:"bytes,12,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,12 +110,66 @@ func (x *SearchRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetTagMatch() string {
+	if x != nil {
+		return x.TagMatch
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *SearchRequest) GetPublishedAfter() string {
+	if x != nil {
+		return x.PublishedAfter
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetPublishedBefore() string {
+	if x != nil {
+		return x.PublishedBefore
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
 type SearchResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ContentItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Items    []*ContentItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Total    int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Corrected queries, offered when the search returns few or no results.
+	Suggestions []string `protobuf:"bytes,5,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// Counts over all contents matching the filters, set when include_facets
+	// is requested.
+	Facets        *Facets `protobuf:"bytes,6,opt,name=facets,proto3" json:"facets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,27 +232,45 @@ func (x *SearchResponse) GetTotal() int64 {
 	return 0
 }
 
-type GetContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *SearchResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetFacets() *Facets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type Facets struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ContentTypes []*FacetValue          `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	Providers    []*FacetValue          `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	Tags         []*FacetValue          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Cumulative buckets: past_day, past_week, past_month, past_year.
+	PublishedAt   []*FacetValue `protobuf:"bytes,4,rep,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContentRequest) Reset() {
-	*x = GetContentRequest{}
+func (x *Facets) Reset() {
+	*x = Facets{}
 	mi := &file_proto_content_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContentRequest) String() string {
+func (x *Facets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContentRequest) ProtoMessage() {}
+func (*Facets) ProtoMessage() {}
 
-func (x *GetContentRequest) ProtoReflect() protoreflect.Message {
+func (x *Facets) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -198,39 +282,62 @@ func (x *GetContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContentRequest.ProtoReflect.Descriptor instead.
-func (*GetContentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Facets.ProtoReflect.Descriptor instead.
+func (*Facets) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetContentRequest) GetId() int64 {
+func (x *Facets) GetContentTypes() []*FacetValue {
 	if x != nil {
-		return x.Id
+		return x.ContentTypes
 	}
-	return 0
+	return nil
 }
 
-type GetContentResponse struct {
+func (x *Facets) GetProviders() []*FacetValue {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *Facets) GetTags() []*FacetValue {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Facets) GetPublishedAt() []*FacetValue {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+type FacetValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       *ContentItem           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContentResponse) Reset() {
-	*x = GetContentResponse{}
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
 	mi := &file_proto_content_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContentResponse) String() string {
+func (x *FacetValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContentResponse) ProtoMessage() {}
+func (*FacetValue) ProtoMessage() {}
 
-func (x *GetContentResponse) ProtoReflect() protoreflect.Message {
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,38 +349,55 @@ func (x *GetContentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContentResponse.ProtoReflect.Descriptor instead.
-func (*GetContentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetContentResponse) GetContent() *ContentItem {
+func (x *FacetValue) GetValue() string {
 	if x != nil {
-		return x.Content
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type GetMetadataRequest struct {
+func (x *FacetValue) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SuggestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetadataRequest) Reset() {
-	*x = GetMetadataRequest{}
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
 	mi := &file_proto_content_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetadataRequest) String() string {
+func (x *SuggestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataRequest) ProtoMessage() {}
+func (*SuggestRequest) ProtoMessage() {}
 
-func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -285,34 +409,54 @@ func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
-func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{4}
 }
 
-type GetMetadataResponse struct {
+func (x *SuggestRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Suggestion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContentTypes  []*ContentTypeMetadata `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
-	SortOptions   []*SortOptionMetadata  `protobuf:"bytes,2,rep,name=sort_options,json=sortOptions,proto3" json:"sort_options,omitempty"`
-	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // tag or title
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMetadataResponse) Reset() {
-	*x = GetMetadataResponse{}
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
 	mi := &file_proto_content_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMetadataResponse) String() string {
+func (x *Suggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataResponse) ProtoMessage() {}
+func (*Suggestion) ProtoMessage() {}
 
-func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -324,54 +468,46 @@ func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
-func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetMetadataResponse) GetContentTypes() []*ContentTypeMetadata {
-	if x != nil {
-		return x.ContentTypes
-	}
-	return nil
-}
-
-func (x *GetMetadataResponse) GetSortOptions() []*SortOptionMetadata {
+func (x *Suggestion) GetText() string {
 	if x != nil {
-		return x.SortOptions
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMetadataResponse) GetPagination() *PaginationMetadata {
+func (x *Suggestion) GetKind() string {
 	if x != nil {
-		return x.Pagination
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type ContentTypeMetadata struct {
+type SuggestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContentTypeMetadata) Reset() {
-	*x = ContentTypeMetadata{}
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
 	mi := &file_proto_content_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContentTypeMetadata) String() string {
+func (x *SuggestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContentTypeMetadata) ProtoMessage() {}
+func (*SuggestResponse) ProtoMessage() {}
 
-func (x *ContentTypeMetadata) ProtoReflect() protoreflect.Message {
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,47 +519,39 @@ func (x *ContentTypeMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContentTypeMetadata.ProtoReflect.Descriptor instead.
-func (*ContentTypeMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ContentTypeMetadata) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ContentTypeMetadata) GetDisplayName() string {
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
 	if x != nil {
-		return x.DisplayName
+		return x.Suggestions
 	}
-	return ""
+	return nil
 }
 
-type SortOptionMetadata struct {
+type GetContentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SortOptionMetadata) Reset() {
-	*x = SortOptionMetadata{}
+func (x *GetContentRequest) Reset() {
+	*x = GetContentRequest{}
 	mi := &file_proto_content_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortOptionMetadata) String() string {
+func (x *GetContentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortOptionMetadata) ProtoMessage() {}
+func (*GetContentRequest) ProtoMessage() {}
 
-func (x *SortOptionMetadata) ProtoReflect() protoreflect.Message {
+func (x *GetContentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -435,47 +563,39 @@ func (x *SortOptionMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortOptionMetadata.ProtoReflect.Descriptor instead.
-func (*SortOptionMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContentRequest.ProtoReflect.Descriptor instead.
+func (*GetContentRequest) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SortOptionMetadata) GetId() string {
+func (x *GetContentRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
-}
-
-func (x *SortOptionMetadata) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+	return 0
 }
 
-type PaginationMetadata struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	DefaultPageSize int32                  `protobuf:"varint,1,opt,name=default_page_size,json=defaultPageSize,proto3" json:"default_page_size,omitempty"`
-	MaxPageSize     int32                  `protobuf:"varint,2,opt,name=max_page_size,json=maxPageSize,proto3" json:"max_page_size,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       *ContentItem           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMetadata) Reset() {
-	*x = PaginationMetadata{}
+func (x *GetContentResponse) Reset() {
+	*x = GetContentResponse{}
 	mi := &file_proto_content_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMetadata) String() string {
+func (x *GetContentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMetadata) ProtoMessage() {}
+func (*GetContentResponse) ProtoMessage() {}
 
-func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
+func (x *GetContentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -487,51 +607,39 @@ func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
-func (*PaginationMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContentResponse.ProtoReflect.Descriptor instead.
+func (*GetContentResponse) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PaginationMetadata) GetDefaultPageSize() int32 {
-	if x != nil {
-		return x.DefaultPageSize
-	}
-	return 0
-}
-
-func (x *PaginationMetadata) GetMaxPageSize() int32 {
+func (x *GetContentResponse) GetContent() *ContentItem {
 	if x != nil {
-		return x.MaxPageSize
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-type ContentItem struct {
+type GetContentRawPayloadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
-	PublishedAt   string                 `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	ProviderName  string                 `protobuf:"bytes,6,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContentItem) Reset() {
-	*x = ContentItem{}
+func (x *GetContentRawPayloadRequest) Reset() {
+	*x = GetContentRawPayloadRequest{}
 	mi := &file_proto_content_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContentItem) String() string {
+func (x *GetContentRawPayloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContentItem) ProtoMessage() {}
+func (*GetContentRawPayloadRequest) ProtoMessage() {}
 
-func (x *ContentItem) ProtoReflect() protoreflect.Message {
+func (x *GetContentRawPayloadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_content_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,74 +651,2801 @@ func (x *ContentItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContentItem.ProtoReflect.Descriptor instead.
-func (*ContentItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContentRawPayloadRequest.ProtoReflect.Descriptor instead.
+func (*GetContentRawPayloadRequest) Descriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ContentItem) GetId() int64 {
+func (x *GetContentRawPayloadRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ContentItem) GetTitle() string {
-	if x != nil {
+type GetContentRawPayloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentId     int64                  `protobuf:"varint,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	ProviderId    int64                  `protobuf:"varint,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	FetchedAt     string                 `protobuf:"bytes,3,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	Payload       *structpb.Value        `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentRawPayloadResponse) Reset() {
+	*x = GetContentRawPayloadResponse{}
+	mi := &file_proto_content_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentRawPayloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentRawPayloadResponse) ProtoMessage() {}
+
+func (x *GetContentRawPayloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentRawPayloadResponse.ProtoReflect.Descriptor instead.
+func (*GetContentRawPayloadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetContentRawPayloadResponse) GetContentId() int64 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *GetContentRawPayloadResponse) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *GetContentRawPayloadResponse) GetFetchedAt() string {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return ""
+}
+
+func (x *GetContentRawPayloadResponse) GetPayload() *structpb.Value {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type GetMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataRequest) Reset() {
+	*x = GetMetadataRequest{}
+	mi := &file_proto_content_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataRequest) ProtoMessage() {}
+
+func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{11}
+}
+
+type GetMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentTypes  []*ContentTypeMetadata `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	SortOptions   []*SortOptionMetadata  `protobuf:"bytes,2,rep,name=sort_options,json=sortOptions,proto3" json:"sort_options,omitempty"`
+	Pagination    *PaginationMetadata    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMetadataResponse) Reset() {
+	*x = GetMetadataResponse{}
+	mi := &file_proto_content_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResponse) ProtoMessage() {}
+
+func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMetadataResponse) GetContentTypes() []*ContentTypeMetadata {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetSortOptions() []*SortOptionMetadata {
+	if x != nil {
+		return x.SortOptions
+	}
+	return nil
+}
+
+func (x *GetMetadataResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ContentTypeMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentTypeMetadata) Reset() {
+	*x = ContentTypeMetadata{}
+	mi := &file_proto_content_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentTypeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentTypeMetadata) ProtoMessage() {}
+
+func (x *ContentTypeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentTypeMetadata.ProtoReflect.Descriptor instead.
+func (*ContentTypeMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ContentTypeMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentTypeMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type SortOptionMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortOptionMetadata) Reset() {
+	*x = SortOptionMetadata{}
+	mi := &file_proto_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortOptionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortOptionMetadata) ProtoMessage() {}
+
+func (x *SortOptionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortOptionMetadata.ProtoReflect.Descriptor instead.
+func (*SortOptionMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SortOptionMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SortOptionMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type PaginationMetadata struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DefaultPageSize int32                  `protobuf:"varint,1,opt,name=default_page_size,json=defaultPageSize,proto3" json:"default_page_size,omitempty"`
+	MaxPageSize     int32                  `protobuf:"varint,2,opt,name=max_page_size,json=maxPageSize,proto3" json:"max_page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaginationMetadata) Reset() {
+	*x = PaginationMetadata{}
+	mi := &file_proto_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationMetadata) ProtoMessage() {}
+
+func (x *PaginationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationMetadata.ProtoReflect.Descriptor instead.
+func (*PaginationMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PaginationMetadata) GetDefaultPageSize() int32 {
+	if x != nil {
+		return x.DefaultPageSize
+	}
+	return 0
+}
+
+func (x *PaginationMetadata) GetMaxPageSize() int32 {
+	if x != nil {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+type ContentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	PublishedAt   string                 `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ProviderName  string                 `protobuf:"bytes,6,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"` // same as provider.name, kept for older clients
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Provider      *Provider              `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentItem) Reset() {
+	*x = ContentItem{}
+	mi := &file_proto_content_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentItem) ProtoMessage() {}
+
+func (x *ContentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentItem.ProtoReflect.Descriptor instead.
+func (*ContentItem) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ContentItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContentItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ContentItem) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ContentItem) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ContentItem) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *ContentItem) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *ContentItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ContentItem) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type Provider struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format         string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	IsEnabled      bool                   `protobuf:"varint,4,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	BaseUrl        string                 `protobuf:"bytes,5,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`                      // admin responses only
+	SyncSchedule   *SyncSchedule          `protobuf:"bytes,6,opt,name=sync_schedule,json=syncSchedule,proto3" json:"sync_schedule,omitempty"`       // admin responses only
+	FeedPagination *FeedPagination        `protobuf:"bytes,7,opt,name=feed_pagination,json=feedPagination,proto3" json:"feed_pagination,omitempty"` // admin responses only
+	FeedMapping    *FeedMapping           `protobuf:"bytes,8,opt,name=feed_mapping,json=feedMapping,proto3" json:"feed_mapping,omitempty"`          // admin responses only
+	FeedAuth       *FeedAuth              `protobuf:"bytes,9,opt,name=feed_auth,json=feedAuth,proto3" json:"feed_auth,omitempty"`                   // admin responses only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	mi := &file_proto_content_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Provider) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Provider) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *Provider) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Provider) GetSyncSchedule() *SyncSchedule {
+	if x != nil {
+		return x.SyncSchedule
+	}
+	return nil
+}
+
+func (x *Provider) GetFeedPagination() *FeedPagination {
+	if x != nil {
+		return x.FeedPagination
+	}
+	return nil
+}
+
+func (x *Provider) GetFeedMapping() *FeedMapping {
+	if x != nil {
+		return x.FeedMapping
+	}
+	return nil
+}
+
+func (x *Provider) GetFeedAuth() *FeedAuth {
+	if x != nil {
+		return x.FeedAuth
+	}
+	return nil
+}
+
+// SyncSchedule controls when a provider is synced. cron (standard five
+// fields or descriptors such as "@hourly") takes precedence over
+// interval_seconds; with neither set the global sync interval applies. Each
+// run is delayed by a random amount up to jitter_seconds.
+type SyncSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalSeconds int32                  `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Cron            string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	JitterSeconds   int32                  `protobuf:"varint,3,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SyncSchedule) Reset() {
+	*x = SyncSchedule{}
+	mi := &file_proto_content_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSchedule) ProtoMessage() {}
+
+func (x *SyncSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSchedule.ProtoReflect.Descriptor instead.
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SyncSchedule) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *SyncSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *SyncSchedule) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+// FeedPagination controls how a provider feed spread over several pages is
+// followed. strategy is one of "page", "offset", "cursor" or "link"; empty
+// fetches a single page. param carries the page number, offset or cursor,
+// and cursor_field names the body field holding the next cursor. At most
+// max_pages pages are fetched, each retried up to page_retries times.
+type FeedPagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategy      string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Param         string                 `protobuf:"bytes,2,opt,name=param,proto3" json:"param,omitempty"`
+	SizeParam     string                 `protobuf:"bytes,3,opt,name=size_param,json=sizeParam,proto3" json:"size_param,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	FirstPage     int32                  `protobuf:"varint,5,opt,name=first_page,json=firstPage,proto3" json:"first_page,omitempty"`
+	CursorField   string                 `protobuf:"bytes,6,opt,name=cursor_field,json=cursorField,proto3" json:"cursor_field,omitempty"`
+	MaxPages      int32                  `protobuf:"varint,7,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	PageRetries   int32                  `protobuf:"varint,8,opt,name=page_retries,json=pageRetries,proto3" json:"page_retries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedPagination) Reset() {
+	*x = FeedPagination{}
+	mi := &file_proto_content_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedPagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedPagination) ProtoMessage() {}
+
+func (x *FeedPagination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedPagination.ProtoReflect.Descriptor instead.
+func (*FeedPagination) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeedPagination) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *FeedPagination) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *FeedPagination) GetSizeParam() string {
+	if x != nil {
+		return x.SizeParam
+	}
+	return ""
+}
+
+func (x *FeedPagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FeedPagination) GetFirstPage() int32 {
+	if x != nil {
+		return x.FirstPage
+	}
+	return 0
+}
+
+func (x *FeedPagination) GetCursorField() string {
+	if x != nil {
+		return x.CursorField
+	}
+	return ""
+}
+
+func (x *FeedPagination) GetMaxPages() int32 {
+	if x != nil {
+		return x.MaxPages
+	}
+	return 0
+}
+
+func (x *FeedPagination) GetPageRetries() int32 {
+	if x != nil {
+		return x.PageRetries
+	}
+	return 0
+}
+
+// FeedMapping reads a feed of the provider's own schema. items selects the
+// items in the document, field paths are relative to an item: JSONPath
+// ("$.data.posts[*]", "$.stats.views") for JSON feeds, XPath
+// ("/rss/channel/item", "stats/@views") for XML feeds. Without items the
+// format's built-in schema is used. CSV feeds take no items path: paths name
+// header columns, a field without one reads the column named after it, and
+// delimiter separates fields (a comma by default).
+type FeedMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         string                 `protobuf:"bytes,1,opt,name=items,proto3" json:"items,omitempty"`
+	Id            *FieldMapping          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *FieldMapping          `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Type          *FieldMapping          `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Views         *FieldMapping          `protobuf:"bytes,5,opt,name=views,proto3" json:"views,omitempty"`
+	Likes         *FieldMapping          `protobuf:"bytes,6,opt,name=likes,proto3" json:"likes,omitempty"`
+	Duration      *FieldMapping          `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"` // seconds
+	ReadingTime   *FieldMapping          `protobuf:"bytes,8,opt,name=reading_time,json=readingTime,proto3" json:"reading_time,omitempty"`
+	Reactions     *FieldMapping          `protobuf:"bytes,9,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	Comments      *FieldMapping          `protobuf:"bytes,10,opt,name=comments,proto3" json:"comments,omitempty"`
+	PublishedAt   *FieldMapping          `protobuf:"bytes,11,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Tags          *FieldMapping          `protobuf:"bytes,12,opt,name=tags,proto3" json:"tags,omitempty"`
+	Delimiter     string                 `protobuf:"bytes,13,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedMapping) Reset() {
+	*x = FeedMapping{}
+	mi := &file_proto_content_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedMapping) ProtoMessage() {}
+
+func (x *FeedMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedMapping.ProtoReflect.Descriptor instead.
+func (*FeedMapping) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedMapping) GetItems() string {
+	if x != nil {
+		return x.Items
+	}
+	return ""
+}
+
+func (x *FeedMapping) GetId() *FieldMapping {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetTitle() *FieldMapping {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetType() *FieldMapping {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetViews() *FieldMapping {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetLikes() *FieldMapping {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetDuration() *FieldMapping {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetReadingTime() *FieldMapping {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetReactions() *FieldMapping {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetComments() *FieldMapping {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetPublishedAt() *FieldMapping {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetTags() *FieldMapping {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FeedMapping) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+// FieldMapping reads one field. default applies when path selects nothing.
+// format is the layout of published_at: "rfc3339" (default), "unix",
+// "unix_ms" or a Go time layout.
+// FeedAuth authenticates feed requests. The secret, and any header or query
+// value holding one, is a reference, env:NAME or file:/path, never the
+// secret itself.
+type FeedAuth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // api_key, bearer, basic or oauth2_client_credentials
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	HeaderName    string                 `protobuf:"bytes,3,opt,name=header_name,json=headerName,proto3" json:"header_name,omitempty"` // api_key, X-API-Key by default
+	QueryParam    string                 `protobuf:"bytes,4,opt,name=query_param,json=queryParam,proto3" json:"query_param,omitempty"` // api_key, sends the key in the query instead
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`                       // basic
+	ClientId      string                 `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`       // oauth2_client_credentials
+	TokenUrl      string                 `protobuf:"bytes,7,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`       // oauth2_client_credentials
+	Scopes        []string               `protobuf:"bytes,8,rep,name=scopes,proto3" json:"scopes,omitempty"`                           // oauth2_client_credentials
+	Headers       map[string]string      `protobuf:"bytes,9,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Query         map[string]string      `protobuf:"bytes,10,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedAuth) Reset() {
+	*x = FeedAuth{}
+	mi := &file_proto_content_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedAuth) ProtoMessage() {}
+
+func (x *FeedAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedAuth.ProtoReflect.Descriptor instead.
+func (*FeedAuth) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FeedAuth) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetHeaderName() string {
+	if x != nil {
+		return x.HeaderName
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetQueryParam() string {
+	if x != nil {
+		return x.QueryParam
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *FeedAuth) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *FeedAuth) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *FeedAuth) GetQuery() map[string]string {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type FieldMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Transforms    []*ValueTransform      `protobuf:"bytes,4,rep,name=transforms,proto3" json:"transforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldMapping) Reset() {
+	*x = FieldMapping{}
+	mi := &file_proto_content_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldMapping) ProtoMessage() {}
+
+func (x *FieldMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldMapping.ProtoReflect.Descriptor instead.
+func (*FieldMapping) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FieldMapping) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FieldMapping) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *FieldMapping) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *FieldMapping) GetTransforms() []*ValueTransform {
+	if x != nil {
+		return x.Transforms
+	}
+	return nil
+}
+
+// ValueTransform is one of "trim", "lower", "upper", "split" (arg is the
+// separator), "map" (values replaces matching values), "scale" (arg is the
+// factor) or "duration" ("mm:ss" and the like to seconds).
+type ValueTransform struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Arg           string                 `protobuf:"bytes,2,opt,name=arg,proto3" json:"arg,omitempty"`
+	Values        map[string]string      `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValueTransform) Reset() {
+	*x = ValueTransform{}
+	mi := &file_proto_content_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueTransform) ProtoMessage() {}
+
+func (x *ValueTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueTransform.ProtoReflect.Descriptor instead.
+func (*ValueTransform) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ValueTransform) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ValueTransform) GetArg() string {
+	if x != nil {
+		return x.Arg
+	}
+	return ""
+}
+
+func (x *ValueTransform) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_proto_content_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{24}
+}
+
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_proto_content_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_content_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTagsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContentCount  int64                  `protobuf:"varint,2,opt,name=content_count,json=contentCount,proto3" json:"content_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_content_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetContentCount() int64 {
+	if x != nil {
+		return x.ContentCount
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_content_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateProviderRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Code      string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format    string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	BaseUrl   string                 `protobuf:"bytes,4,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	IsEnabled bool                   `protobuf:"varint,5,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	// Fetch and parse the feed first, and reject the provider if that fails.
+	Verify         bool            `protobuf:"varint,6,opt,name=verify,proto3" json:"verify,omitempty"`
+	SyncSchedule   *SyncSchedule   `protobuf:"bytes,7,opt,name=sync_schedule,json=syncSchedule,proto3" json:"sync_schedule,omitempty"`
+	FeedPagination *FeedPagination `protobuf:"bytes,8,opt,name=feed_pagination,json=feedPagination,proto3" json:"feed_pagination,omitempty"`
+	FeedMapping    *FeedMapping    `protobuf:"bytes,9,opt,name=feed_mapping,json=feedMapping,proto3" json:"feed_mapping,omitempty"`
+	FeedAuth       *FeedAuth       `protobuf:"bytes,10,opt,name=feed_auth,json=feedAuth,proto3" json:"feed_auth,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateProviderRequest) Reset() {
+	*x = CreateProviderRequest{}
+	mi := &file_proto_content_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProviderRequest) ProtoMessage() {}
+
+func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
+func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateProviderRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProviderRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateProviderRequest) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *CreateProviderRequest) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *CreateProviderRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+func (x *CreateProviderRequest) GetSyncSchedule() *SyncSchedule {
+	if x != nil {
+		return x.SyncSchedule
+	}
+	return nil
+}
+
+func (x *CreateProviderRequest) GetFeedPagination() *FeedPagination {
+	if x != nil {
+		return x.FeedPagination
+	}
+	return nil
+}
+
+func (x *CreateProviderRequest) GetFeedMapping() *FeedMapping {
+	if x != nil {
+		return x.FeedMapping
+	}
+	return nil
+}
+
+func (x *CreateProviderRequest) GetFeedAuth() *FeedAuth {
+	if x != nil {
+		return x.FeedAuth
+	}
+	return nil
+}
+
+// Fields left unset keep the provider's current value; an empty message
+// clears the setting.
+type UpdateProviderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format         string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	BaseUrl        string                 `protobuf:"bytes,4,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	Verify         bool                   `protobuf:"varint,5,opt,name=verify,proto3" json:"verify,omitempty"`
+	SyncSchedule   *SyncSchedule          `protobuf:"bytes,6,opt,name=sync_schedule,json=syncSchedule,proto3" json:"sync_schedule,omitempty"`
+	FeedPagination *FeedPagination        `protobuf:"bytes,7,opt,name=feed_pagination,json=feedPagination,proto3" json:"feed_pagination,omitempty"`
+	FeedMapping    *FeedMapping           `protobuf:"bytes,8,opt,name=feed_mapping,json=feedMapping,proto3" json:"feed_mapping,omitempty"`
+	FeedAuth       *FeedAuth              `protobuf:"bytes,9,opt,name=feed_auth,json=feedAuth,proto3" json:"feed_auth,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProviderRequest) Reset() {
+	*x = UpdateProviderRequest{}
+	mi := &file_proto_content_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProviderRequest) ProtoMessage() {}
+
+func (x *UpdateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProviderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateProviderRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateProviderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProviderRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UpdateProviderRequest) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *UpdateProviderRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+func (x *UpdateProviderRequest) GetSyncSchedule() *SyncSchedule {
+	if x != nil {
+		return x.SyncSchedule
+	}
+	return nil
+}
+
+func (x *UpdateProviderRequest) GetFeedPagination() *FeedPagination {
+	if x != nil {
+		return x.FeedPagination
+	}
+	return nil
+}
+
+func (x *UpdateProviderRequest) GetFeedMapping() *FeedMapping {
+	if x != nil {
+		return x.FeedMapping
+	}
+	return nil
+}
+
+func (x *UpdateProviderRequest) GetFeedAuth() *FeedAuth {
+	if x != nil {
+		return x.FeedAuth
+	}
+	return nil
+}
+
+type SetProviderEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IsEnabled     bool                   `protobuf:"varint,2,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProviderEnabledRequest) Reset() {
+	*x = SetProviderEnabledRequest{}
+	mi := &file_proto_content_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProviderEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderEnabledRequest) ProtoMessage() {}
+
+func (x *SetProviderEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProviderEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetProviderEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetProviderEnabledRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetProviderEnabledRequest) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+type DeleteProviderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProviderRequest) Reset() {
+	*x = DeleteProviderRequest{}
+	mi := &file_proto_content_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderRequest) ProtoMessage() {}
+
+func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteProviderRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProviderResponse) Reset() {
+	*x = DeleteProviderResponse{}
+	mi := &file_proto_content_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProviderResponse) ProtoMessage() {}
+
+func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{33}
+}
+
+type ProviderAdminResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Items fetched when verify was requested.
+	VerifiedItemCount int32 `protobuf:"varint,2,opt,name=verified_item_count,json=verifiedItemCount,proto3" json:"verified_item_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProviderAdminResponse) Reset() {
+	*x = ProviderAdminResponse{}
+	mi := &file_proto_content_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderAdminResponse) ProtoMessage() {}
+
+func (x *ProviderAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderAdminResponse.ProtoReflect.Descriptor instead.
+func (*ProviderAdminResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProviderAdminResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *ProviderAdminResponse) GetVerifiedItemCount() int32 {
+	if x != nil {
+		return x.VerifiedItemCount
+	}
+	return 0
+}
+
+type TriggerSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty syncs every enabled provider.
+	ProviderCode  string `protobuf:"bytes,1,opt,name=provider_code,json=providerCode,proto3" json:"provider_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerSyncRequest) Reset() {
+	*x = TriggerSyncRequest{}
+	mi := &file_proto_content_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerSyncRequest) ProtoMessage() {}
+
+func (x *TriggerSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerSyncRequest.ProtoReflect.Descriptor instead.
+func (*TriggerSyncRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TriggerSyncRequest) GetProviderCode() string {
+	if x != nil {
+		return x.ProviderCode
+	}
+	return ""
+}
+
+type TriggerSyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Runs  []*SyncRun             `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	// Providers that already had a sync running.
+	SkippedProviderCodes []string `protobuf:"bytes,2,rep,name=skipped_provider_codes,json=skippedProviderCodes,proto3" json:"skipped_provider_codes,omitempty"`
+	// Providers whose format has no client; they are not synced.
+	UnsupportedProviderCodes []string `protobuf:"bytes,3,rep,name=unsupported_provider_codes,json=unsupportedProviderCodes,proto3" json:"unsupported_provider_codes,omitempty"`
+	// Providers whose sync could not be started; the rest are still started.
+	FailedProviderCodes []string `protobuf:"bytes,4,rep,name=failed_provider_codes,json=failedProviderCodes,proto3" json:"failed_provider_codes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TriggerSyncResponse) Reset() {
+	*x = TriggerSyncResponse{}
+	mi := &file_proto_content_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerSyncResponse) ProtoMessage() {}
+
+func (x *TriggerSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerSyncResponse.ProtoReflect.Descriptor instead.
+func (*TriggerSyncResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TriggerSyncResponse) GetRuns() []*SyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *TriggerSyncResponse) GetSkippedProviderCodes() []string {
+	if x != nil {
+		return x.SkippedProviderCodes
+	}
+	return nil
+}
+
+func (x *TriggerSyncResponse) GetUnsupportedProviderCodes() []string {
+	if x != nil {
+		return x.UnsupportedProviderCodes
+	}
+	return nil
+}
+
+func (x *TriggerSyncResponse) GetFailedProviderCodes() []string {
+	if x != nil {
+		return x.FailedProviderCodes
+	}
+	return nil
+}
+
+type PreviewProviderMappingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Format         string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	BaseUrl        string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	FeedPagination *FeedPagination        `protobuf:"bytes,3,opt,name=feed_pagination,json=feedPagination,proto3" json:"feed_pagination,omitempty"`
+	FeedMapping    *FeedMapping           `protobuf:"bytes,4,opt,name=feed_mapping,json=feedMapping,proto3" json:"feed_mapping,omitempty"`
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // items to return, 5 by default and at most 50
+	FeedAuth       *FeedAuth              `protobuf:"bytes,6,opt,name=feed_auth,json=feedAuth,proto3" json:"feed_auth,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewProviderMappingRequest) Reset() {
+	*x = PreviewProviderMappingRequest{}
+	mi := &file_proto_content_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewProviderMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewProviderMappingRequest) ProtoMessage() {}
+
+func (x *PreviewProviderMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewProviderMappingRequest.ProtoReflect.Descriptor instead.
+func (*PreviewProviderMappingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PreviewProviderMappingRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PreviewProviderMappingRequest) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *PreviewProviderMappingRequest) GetFeedPagination() *FeedPagination {
+	if x != nil {
+		return x.FeedPagination
+	}
+	return nil
+}
+
+func (x *PreviewProviderMappingRequest) GetFeedMapping() *FeedMapping {
+	if x != nil {
+		return x.FeedMapping
+	}
+	return nil
+}
+
+func (x *PreviewProviderMappingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PreviewProviderMappingRequest) GetFeedAuth() *FeedAuth {
+	if x != nil {
+		return x.FeedAuth
+	}
+	return nil
+}
+
+type MappedItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProviderContentId string                 `protobuf:"bytes,1,opt,name=provider_content_id,json=providerContentId,proto3" json:"provider_content_id,omitempty"`
+	Title             string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ContentType       string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Views             int64                  `protobuf:"varint,4,opt,name=views,proto3" json:"views,omitempty"`
+	Likes             int64                  `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	DurationSec       int32                  `protobuf:"varint,6,opt,name=duration_sec,json=durationSec,proto3" json:"duration_sec,omitempty"`
+	ReadingTime       int32                  `protobuf:"varint,7,opt,name=reading_time,json=readingTime,proto3" json:"reading_time,omitempty"`
+	Reactions         int64                  `protobuf:"varint,8,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	Comments          int64                  `protobuf:"varint,9,opt,name=comments,proto3" json:"comments,omitempty"`
+	PublishedAt       string                 `protobuf:"bytes,10,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Tags              []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MappedItem) Reset() {
+	*x = MappedItem{}
+	mi := &file_proto_content_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MappedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MappedItem) ProtoMessage() {}
+
+func (x *MappedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MappedItem.ProtoReflect.Descriptor instead.
+func (*MappedItem) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MappedItem) GetProviderContentId() string {
+	if x != nil {
+		return x.ProviderContentId
+	}
+	return ""
+}
+
+func (x *MappedItem) GetTitle() string {
+	if x != nil {
 		return x.Title
 	}
-	return ""
+	return ""
+}
+
+func (x *MappedItem) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MappedItem) GetViews() int64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *MappedItem) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *MappedItem) GetDurationSec() int32 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+func (x *MappedItem) GetReadingTime() int32 {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return 0
+}
+
+func (x *MappedItem) GetReactions() int64 {
+	if x != nil {
+		return x.Reactions
+	}
+	return 0
+}
+
+func (x *MappedItem) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *MappedItem) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *MappedItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PreviewProviderMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MappedItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	PageItemCount int32                  `protobuf:"varint,2,opt,name=page_item_count,json=pageItemCount,proto3" json:"page_item_count,omitempty"` // items on the feed's first page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewProviderMappingResponse) Reset() {
+	*x = PreviewProviderMappingResponse{}
+	mi := &file_proto_content_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewProviderMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewProviderMappingResponse) ProtoMessage() {}
+
+func (x *PreviewProviderMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewProviderMappingResponse.ProtoReflect.Descriptor instead.
+func (*PreviewProviderMappingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PreviewProviderMappingResponse) GetItems() []*MappedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PreviewProviderMappingResponse) GetPageItemCount() int32 {
+	if x != nil {
+		return x.PageItemCount
+	}
+	return 0
+}
+
+type CircuitBreaker struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProviderCode string                 `protobuf:"bytes,1,opt,name=provider_code,json=providerCode,proto3" json:"provider_code,omitempty"`
+	State        string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // closed, half-open or open
+	// Counts of the breaker's current interval.
+	Requests             uint32 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	TotalSuccesses       uint32 `protobuf:"varint,4,opt,name=total_successes,json=totalSuccesses,proto3" json:"total_successes,omitempty"`
+	TotalFailures        uint32 `protobuf:"varint,5,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty"`
+	ConsecutiveSuccesses uint32 `protobuf:"varint,6,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  uint32 `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	mi := &file_proto_content_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CircuitBreaker) GetProviderCode() string {
+	if x != nil {
+		return x.ProviderCode
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetRequests() uint32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetTotalSuccesses() uint32 {
+	if x != nil {
+		return x.TotalSuccesses
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetTotalFailures() uint32 {
+	if x != nil {
+		return x.TotalFailures
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetConsecutiveSuccesses() uint32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+type ListCircuitBreakersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCircuitBreakersRequest) Reset() {
+	*x = ListCircuitBreakersRequest{}
+	mi := &file_proto_content_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCircuitBreakersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitBreakersRequest) ProtoMessage() {}
+
+func (x *ListCircuitBreakersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitBreakersRequest.ProtoReflect.Descriptor instead.
+func (*ListCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{41}
+}
+
+type ListCircuitBreakersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CircuitBreakers []*CircuitBreaker      `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCircuitBreakersResponse) Reset() {
+	*x = ListCircuitBreakersResponse{}
+	mi := &file_proto_content_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCircuitBreakersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitBreakersResponse) ProtoMessage() {}
+
+func (x *ListCircuitBreakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitBreakersResponse.ProtoReflect.Descriptor instead.
+func (*ListCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListCircuitBreakersResponse) GetCircuitBreakers() []*CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+type ResetCircuitBreakerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetCircuitBreakerRequest) Reset() {
+	*x = ResetCircuitBreakerRequest{}
+	mi := &file_proto_content_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCircuitBreakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCircuitBreakerRequest) ProtoMessage() {}
+
+func (x *ResetCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*ResetCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResetCircuitBreakerRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ResetCircuitBreakerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CircuitBreaker *CircuitBreaker        `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResetCircuitBreakerResponse) Reset() {
+	*x = ResetCircuitBreakerResponse{}
+	mi := &file_proto_content_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetCircuitBreakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCircuitBreakerResponse) ProtoMessage() {}
+
+func (x *ResetCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ContentItem) GetContentType() string {
+// Deprecated: Use ResetCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*ResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResetCircuitBreakerResponse) GetCircuitBreaker() *CircuitBreaker {
 	if x != nil {
-		return x.ContentType
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+type ListSyncRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderCode  string                 `protobuf:"bytes,1,opt,name=provider_code,json=providerCode,proto3" json:"provider_code,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsRequest) Reset() {
+	*x = ListSyncRunsRequest{}
+	mi := &file_proto_content_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsRequest) ProtoMessage() {}
+
+func (x *ListSyncRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListSyncRunsRequest) GetProviderCode() string {
+	if x != nil {
+		return x.ProviderCode
 	}
 	return ""
 }
 
-func (x *ContentItem) GetScore() float64 {
+func (x *ListSyncRunsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Score
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *ContentItem) GetPublishedAt() string {
+type ListSyncRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*SyncRun             `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSyncRunsResponse) Reset() {
+	*x = ListSyncRunsResponse{}
+	mi := &file_proto_content_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSyncRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSyncRunsResponse) ProtoMessage() {}
+
+func (x *ListSyncRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[46]
 	if x != nil {
-		return x.PublishedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSyncRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncRunsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListSyncRunsResponse) GetRuns() []*SyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetSyncRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRunRequest) Reset() {
+	*x = GetSyncRunRequest{}
+	mi := &file_proto_content_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRunRequest) ProtoMessage() {}
+
+func (x *GetSyncRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRunRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncRunRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSyncRunRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetSyncRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *SyncRun               `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncRunResponse) Reset() {
+	*x = GetSyncRunResponse{}
+	mi := &file_proto_content_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncRunResponse) ProtoMessage() {}
+
+func (x *GetSyncRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncRunResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncRunResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetSyncRunResponse) GetRun() *SyncRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type SyncRun struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderId       int64                  `protobuf:"varint,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	ProviderCode     string                 `protobuf:"bytes,3,opt,name=provider_code,json=providerCode,proto3" json:"provider_code,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt        string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt       string                 `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ItemCount        int32                  `protobuf:"varint,7,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	UpsertedCount    int32                  `protobuf:"varint,8,opt,name=upserted_count,json=upsertedCount,proto3" json:"upserted_count,omitempty"`
+	StatsCount       int32                  `protobuf:"varint,9,opt,name=stats_count,json=statsCount,proto3" json:"stats_count,omitempty"`
+	TagsCount        int32                  `protobuf:"varint,10,opt,name=tags_count,json=tagsCount,proto3" json:"tags_count,omitempty"`
+	SkippedCount     int32                  `protobuf:"varint,11,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	ErrorMessage     string                 `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	DeactivatedCount int32                  `protobuf:"varint,13,opt,name=deactivated_count,json=deactivatedCount,proto3" json:"deactivated_count,omitempty"`
+	ReactivatedCount int32                  `protobuf:"varint,14,opt,name=reactivated_count,json=reactivatedCount,proto3" json:"reactivated_count,omitempty"`
+	UnchangedCount   int32                  `protobuf:"varint,15,opt,name=unchanged_count,json=unchangedCount,proto3" json:"unchanged_count,omitempty"`
+	NotModified      bool                   `protobuf:"varint,16,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncRun) Reset() {
+	*x = SyncRun{}
+	mi := &file_proto_content_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRun) ProtoMessage() {}
+
+func (x *SyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRun.ProtoReflect.Descriptor instead.
+func (*SyncRun) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SyncRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncRun) GetProviderId() int64 {
+	if x != nil {
+		return x.ProviderId
+	}
+	return 0
+}
+
+func (x *SyncRun) GetProviderCode() string {
+	if x != nil {
+		return x.ProviderCode
 	}
 	return ""
 }
 
-func (x *ContentItem) GetProviderName() string {
+func (x *SyncRun) GetStatus() string {
 	if x != nil {
-		return x.ProviderName
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyncRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *SyncRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *SyncRun) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetUpsertedCount() int32 {
+	if x != nil {
+		return x.UpsertedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetStatsCount() int32 {
+	if x != nil {
+		return x.StatsCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetTagsCount() int32 {
+	if x != nil {
+		return x.TagsCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
+func (x *SyncRun) GetDeactivatedCount() int32 {
+	if x != nil {
+		return x.DeactivatedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetReactivatedCount() int32 {
+	if x != nil {
+		return x.ReactivatedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetUnchangedCount() int32 {
+	if x != nil {
+		return x.UnchangedCount
+	}
+	return 0
+}
+
+func (x *SyncRun) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 var File_proto_content_proto protoreflect.FileDescriptor
 
 const file_proto_content_proto_rawDesc = "" +
 	"\n" +
 	"\x13proto/content.proto\x12\n" +
-	"content.v1\x1a\x1cgoogle/api/annotations.proto\"~\n" +
+	"content.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xeb\x02\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
 	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x86\x01\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12!\n" +
+	"\fexclude_tags\x18\a \x03(\tR\vexcludeTags\x12\x1b\n" +
+	"\ttag_match\x18\b \x01(\tR\btagMatch\x12%\n" +
+	"\x0einclude_facets\x18\t \x01(\bR\rincludeFacets\x12'\n" +
+	"\x0fpublished_after\x18\n" +
+	" \x01(\tR\x0epublishedAfter\x12)\n" +
+	"\x10published_before\x18\v \x01(\tR\x0fpublishedBefore\x12\x1c\n" +
+	"\tproviders\x18\f \x03(\tR\tproviders\"\xd4\x01\n" +
 	"\x0eSearchResponse\x12-\n" +
 	"\x05items\x18\x01 \x03(\v2\x17.content.v1.ContentItemR\x05items\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\x03R\x05total\"#\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\x12 \n" +
+	"\vsuggestions\x18\x05 \x03(\tR\vsuggestions\x12*\n" +
+	"\x06facets\x18\x06 \x01(\v2\x12.content.v1.FacetsR\x06facets\"\xe2\x01\n" +
+	"\x06Facets\x12;\n" +
+	"\rcontent_types\x18\x01 \x03(\v2\x16.content.v1.FacetValueR\fcontentTypes\x124\n" +
+	"\tproviders\x18\x02 \x03(\v2\x16.content.v1.FacetValueR\tproviders\x12*\n" +
+	"\x04tags\x18\x03 \x03(\v2\x16.content.v1.FacetValueR\x04tags\x129\n" +
+	"\fpublished_at\x18\x04 \x03(\v2\x16.content.v1.FacetValueR\vpublishedAt\"N\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"R\n" +
+	"\x0eSuggestRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"4\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"K\n" +
+	"\x0fSuggestResponse\x128\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x16.content.v1.SuggestionR\vsuggestions\"#\n" +
 	"\x11GetContentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"G\n" +
 	"\x12GetContentResponse\x121\n" +
-	"\acontent\x18\x01 \x01(\v2\x17.content.v1.ContentItemR\acontent\"\x14\n" +
+	"\acontent\x18\x01 \x01(\v2\x17.content.v1.ContentItemR\acontent\"-\n" +
+	"\x1bGetContentRawPayloadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xaf\x01\n" +
+	"\x1cGetContentRawPayloadResponse\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\x03R\tcontentId\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\x03R\n" +
+	"providerId\x12\x1d\n" +
+	"\n" +
+	"fetched_at\x18\x03 \x01(\tR\tfetchedAt\x120\n" +
+	"\apayload\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\apayload\"\x14\n" +
 	"\x12GetMetadataRequest\"\xde\x01\n" +
 	"\x13GetMetadataResponse\x12D\n" +
 	"\rcontent_types\x18\x01 \x03(\v2\x1f.content.v1.ContentTypeMetadataR\fcontentTypes\x12A\n" +
@@ -626,19 +3461,236 @@ const file_proto_content_proto_rawDesc = "" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"d\n" +
 	"\x12PaginationMetadata\x12*\n" +
 	"\x11default_page_size\x18\x01 \x01(\x05R\x0fdefaultPageSize\x12\"\n" +
-	"\rmax_page_size\x18\x02 \x01(\x05R\vmaxPageSize\"\xb4\x01\n" +
+	"\rmax_page_size\x18\x02 \x01(\x05R\vmaxPageSize\"\xfa\x01\n" +
 	"\vContentItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
 	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x14\n" +
 	"\x05score\x18\x04 \x01(\x01R\x05score\x12!\n" +
 	"\fpublished_at\x18\x05 \x01(\tR\vpublishedAt\x12#\n" +
-	"\rprovider_name\x18\x06 \x01(\tR\fproviderName2\xc7\x02\n" +
+	"\rprovider_name\x18\x06 \x01(\tR\fproviderName\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x120\n" +
+	"\bprovider\x18\b \x01(\v2\x14.content.v1.ProviderR\bprovider\"\xf7\x02\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x04 \x01(\bR\tisEnabled\x12\x19\n" +
+	"\bbase_url\x18\x05 \x01(\tR\abaseUrl\x12=\n" +
+	"\rsync_schedule\x18\x06 \x01(\v2\x18.content.v1.SyncScheduleR\fsyncSchedule\x12C\n" +
+	"\x0ffeed_pagination\x18\a \x01(\v2\x1a.content.v1.FeedPaginationR\x0efeedPagination\x12:\n" +
+	"\ffeed_mapping\x18\b \x01(\v2\x17.content.v1.FeedMappingR\vfeedMapping\x121\n" +
+	"\tfeed_auth\x18\t \x01(\v2\x14.content.v1.FeedAuthR\bfeedAuth\"t\n" +
+	"\fSyncSchedule\x12)\n" +
+	"\x10interval_seconds\x18\x01 \x01(\x05R\x0fintervalSeconds\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12%\n" +
+	"\x0ejitter_seconds\x18\x03 \x01(\x05R\rjitterSeconds\"\x80\x02\n" +
+	"\x0eFeedPagination\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12\x14\n" +
+	"\x05param\x18\x02 \x01(\tR\x05param\x12\x1d\n" +
+	"\n" +
+	"size_param\x18\x03 \x01(\tR\tsizeParam\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"first_page\x18\x05 \x01(\x05R\tfirstPage\x12!\n" +
+	"\fcursor_field\x18\x06 \x01(\tR\vcursorField\x12\x1b\n" +
+	"\tmax_pages\x18\a \x01(\x05R\bmaxPages\x12!\n" +
+	"\fpage_retries\x18\b \x01(\x05R\vpageRetries\"\xf5\x04\n" +
+	"\vFeedMapping\x12\x14\n" +
+	"\x05items\x18\x01 \x01(\tR\x05items\x12(\n" +
+	"\x02id\x18\x02 \x01(\v2\x18.content.v1.FieldMappingR\x02id\x12.\n" +
+	"\x05title\x18\x03 \x01(\v2\x18.content.v1.FieldMappingR\x05title\x12,\n" +
+	"\x04type\x18\x04 \x01(\v2\x18.content.v1.FieldMappingR\x04type\x12.\n" +
+	"\x05views\x18\x05 \x01(\v2\x18.content.v1.FieldMappingR\x05views\x12.\n" +
+	"\x05likes\x18\x06 \x01(\v2\x18.content.v1.FieldMappingR\x05likes\x124\n" +
+	"\bduration\x18\a \x01(\v2\x18.content.v1.FieldMappingR\bduration\x12;\n" +
+	"\freading_time\x18\b \x01(\v2\x18.content.v1.FieldMappingR\vreadingTime\x126\n" +
+	"\treactions\x18\t \x01(\v2\x18.content.v1.FieldMappingR\treactions\x124\n" +
+	"\bcomments\x18\n" +
+	" \x01(\v2\x18.content.v1.FieldMappingR\bcomments\x12;\n" +
+	"\fpublished_at\x18\v \x01(\v2\x18.content.v1.FieldMappingR\vpublishedAt\x12,\n" +
+	"\x04tags\x18\f \x01(\v2\x18.content.v1.FieldMappingR\x04tags\x12\x1c\n" +
+	"\tdelimiter\x18\r \x01(\tR\tdelimiter\"\xd0\x03\n" +
+	"\bFeedAuth\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vheader_name\x18\x03 \x01(\tR\n" +
+	"headerName\x12\x1f\n" +
+	"\vquery_param\x18\x04 \x01(\tR\n" +
+	"queryParam\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1b\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\x12\x1b\n" +
+	"\ttoken_url\x18\a \x01(\tR\btokenUrl\x12\x16\n" +
+	"\x06scopes\x18\b \x03(\tR\x06scopes\x12;\n" +
+	"\aheaders\x18\t \x03(\v2!.content.v1.FeedAuth.HeadersEntryR\aheaders\x125\n" +
+	"\x05query\x18\n" +
+	" \x03(\v2\x1f.content.v1.FeedAuth.QueryEntryR\x05query\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"QueryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x01\n" +
+	"\fFieldMapping\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12#\n" +
+	"\rdefault_value\x18\x02 \x01(\tR\fdefaultValue\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12:\n" +
+	"\n" +
+	"transforms\x18\x04 \x03(\v2\x1a.content.v1.ValueTransformR\n" +
+	"transforms\"\xb1\x01\n" +
+	"\x0eValueTransform\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03arg\x18\x02 \x01(\tR\x03arg\x12>\n" +
+	"\x06values\x18\x03 \x03(\v2&.content.v1.ValueTransform.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x16\n" +
+	"\x14ListProvidersRequest\"K\n" +
+	"\x15ListProvidersResponse\x122\n" +
+	"\tproviders\x18\x01 \x03(\v2\x14.content.v1.ProviderR\tproviders\";\n" +
+	"\x0fListTagsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rcontent_count\x18\x02 \x01(\x03R\fcontentCount\"<\n" +
+	"\x10ListTagsResponse\x12(\n" +
+	"\x04tags\x18\x01 \x03(\v2\x14.content.v1.TagCountR\x04tags\"\x9c\x03\n" +
+	"\x15CreateProviderRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x19\n" +
+	"\bbase_url\x18\x04 \x01(\tR\abaseUrl\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x05 \x01(\bR\tisEnabled\x12\x16\n" +
+	"\x06verify\x18\x06 \x01(\bR\x06verify\x12=\n" +
+	"\rsync_schedule\x18\a \x01(\v2\x18.content.v1.SyncScheduleR\fsyncSchedule\x12C\n" +
+	"\x0ffeed_pagination\x18\b \x01(\v2\x1a.content.v1.FeedPaginationR\x0efeedPagination\x12:\n" +
+	"\ffeed_mapping\x18\t \x01(\v2\x17.content.v1.FeedMappingR\vfeedMapping\x121\n" +
+	"\tfeed_auth\x18\n" +
+	" \x01(\v2\x14.content.v1.FeedAuthR\bfeedAuth\"\xfd\x02\n" +
+	"\x15UpdateProviderRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x19\n" +
+	"\bbase_url\x18\x04 \x01(\tR\abaseUrl\x12\x16\n" +
+	"\x06verify\x18\x05 \x01(\bR\x06verify\x12=\n" +
+	"\rsync_schedule\x18\x06 \x01(\v2\x18.content.v1.SyncScheduleR\fsyncSchedule\x12C\n" +
+	"\x0ffeed_pagination\x18\a \x01(\v2\x1a.content.v1.FeedPaginationR\x0efeedPagination\x12:\n" +
+	"\ffeed_mapping\x18\b \x01(\v2\x17.content.v1.FeedMappingR\vfeedMapping\x121\n" +
+	"\tfeed_auth\x18\t \x01(\v2\x14.content.v1.FeedAuthR\bfeedAuth\"N\n" +
+	"\x19SetProviderEnabledRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x02 \x01(\bR\tisEnabled\"+\n" +
+	"\x15DeleteProviderRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x18\n" +
+	"\x16DeleteProviderResponse\"y\n" +
+	"\x15ProviderAdminResponse\x120\n" +
+	"\bprovider\x18\x01 \x01(\v2\x14.content.v1.ProviderR\bprovider\x12.\n" +
+	"\x13verified_item_count\x18\x02 \x01(\x05R\x11verifiedItemCount\"9\n" +
+	"\x12TriggerSyncRequest\x12#\n" +
+	"\rprovider_code\x18\x01 \x01(\tR\fproviderCode\"\xe6\x01\n" +
+	"\x13TriggerSyncResponse\x12'\n" +
+	"\x04runs\x18\x01 \x03(\v2\x13.content.v1.SyncRunR\x04runs\x124\n" +
+	"\x16skipped_provider_codes\x18\x02 \x03(\tR\x14skippedProviderCodes\x12<\n" +
+	"\x1aunsupported_provider_codes\x18\x03 \x03(\tR\x18unsupportedProviderCodes\x122\n" +
+	"\x15failed_provider_codes\x18\x04 \x03(\tR\x13failedProviderCodes\"\x9c\x02\n" +
+	"\x1dPreviewProviderMappingRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12C\n" +
+	"\x0ffeed_pagination\x18\x03 \x01(\v2\x1a.content.v1.FeedPaginationR\x0efeedPagination\x12:\n" +
+	"\ffeed_mapping\x18\x04 \x01(\v2\x17.content.v1.FeedMappingR\vfeedMapping\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x121\n" +
+	"\tfeed_auth\x18\x06 \x01(\v2\x14.content.v1.FeedAuthR\bfeedAuth\"\xd8\x02\n" +
+	"\n" +
+	"MappedItem\x12.\n" +
+	"\x13provider_content_id\x18\x01 \x01(\tR\x11providerContentId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05views\x18\x04 \x01(\x03R\x05views\x12\x14\n" +
+	"\x05likes\x18\x05 \x01(\x03R\x05likes\x12!\n" +
+	"\fduration_sec\x18\x06 \x01(\x05R\vdurationSec\x12!\n" +
+	"\freading_time\x18\a \x01(\x05R\vreadingTime\x12\x1c\n" +
+	"\treactions\x18\b \x01(\x03R\treactions\x12\x1a\n" +
+	"\bcomments\x18\t \x01(\x03R\bcomments\x12!\n" +
+	"\fpublished_at\x18\n" +
+	" \x01(\tR\vpublishedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\"v\n" +
+	"\x1ePreviewProviderMappingResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.content.v1.MappedItemR\x05items\x12&\n" +
+	"\x0fpage_item_count\x18\x02 \x01(\x05R\rpageItemCount\"\x9f\x02\n" +
+	"\x0eCircuitBreaker\x12#\n" +
+	"\rprovider_code\x18\x01 \x01(\tR\fproviderCode\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\rR\brequests\x12'\n" +
+	"\x0ftotal_successes\x18\x04 \x01(\rR\x0etotalSuccesses\x12%\n" +
+	"\x0etotal_failures\x18\x05 \x01(\rR\rtotalFailures\x123\n" +
+	"\x15consecutive_successes\x18\x06 \x01(\rR\x14consecutiveSuccesses\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\rR\x13consecutiveFailures\"\x1c\n" +
+	"\x1aListCircuitBreakersRequest\"d\n" +
+	"\x1bListCircuitBreakersResponse\x12E\n" +
+	"\x10circuit_breakers\x18\x01 \x03(\v2\x1a.content.v1.CircuitBreakerR\x0fcircuitBreakers\"0\n" +
+	"\x1aResetCircuitBreakerRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"b\n" +
+	"\x1bResetCircuitBreakerResponse\x12C\n" +
+	"\x0fcircuit_breaker\x18\x01 \x01(\v2\x1a.content.v1.CircuitBreakerR\x0ecircuitBreaker\"P\n" +
+	"\x13ListSyncRunsRequest\x12#\n" +
+	"\rprovider_code\x18\x01 \x01(\tR\fproviderCode\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"?\n" +
+	"\x14ListSyncRunsResponse\x12'\n" +
+	"\x04runs\x18\x01 \x03(\v2\x13.content.v1.SyncRunR\x04runs\"#\n" +
+	"\x11GetSyncRunRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\";\n" +
+	"\x12GetSyncRunResponse\x12%\n" +
+	"\x03run\x18\x01 \x01(\v2\x13.content.v1.SyncRunR\x03run\"\xad\x04\n" +
+	"\aSyncRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\x03R\n" +
+	"providerId\x12#\n" +
+	"\rprovider_code\x18\x03 \x01(\tR\fproviderCode\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x06 \x01(\tR\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\a \x01(\x05R\titemCount\x12%\n" +
+	"\x0eupserted_count\x18\b \x01(\x05R\rupsertedCount\x12\x1f\n" +
+	"\vstats_count\x18\t \x01(\x05R\n" +
+	"statsCount\x12\x1d\n" +
+	"\n" +
+	"tags_count\x18\n" +
+	" \x01(\x05R\ttagsCount\x12#\n" +
+	"\rskipped_count\x18\v \x01(\x05R\fskippedCount\x12#\n" +
+	"\rerror_message\x18\f \x01(\tR\ferrorMessage\x12+\n" +
+	"\x11deactivated_count\x18\r \x01(\x05R\x10deactivatedCount\x12+\n" +
+	"\x11reactivated_count\x18\x0e \x01(\x05R\x10reactivatedCount\x12'\n" +
+	"\x0funchanged_count\x18\x0f \x01(\x05R\x0eunchangedCount\x12!\n" +
+	"\fnot_modified\x18\x10 \x01(\bR\vnotModified2\xf7\a\n" +
 	"\x0eContentService\x12_\n" +
-	"\x0eSearchContents\x12\x19.content.v1.SearchRequest\x1a\x1a.content.v1.SearchResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/search\x12j\n" +
+	"\x0eSearchContents\x12\x19.content.v1.SearchRequest\x1a\x1a.content.v1.SearchResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/search\x12[\n" +
+	"\aSuggest\x12\x1a.content.v1.SuggestRequest\x1a\x1b.content.v1.SuggestResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/suggest\x12j\n" +
+	"\n" +
+	"GetContent\x12\x1d.content.v1.GetContentRequest\x1a\x1e.content.v1.GetContentResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/contents/{id}\x12\x8c\x01\n" +
+	"\x14GetContentRawPayload\x12'.content.v1.GetContentRawPayloadRequest\x1a(.content.v1.GetContentRawPayloadResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/contents/{id}/raw\x12[\n" +
+	"\bListTags\x12\x1b.content.v1.ListTagsRequest\x1a\x1c.content.v1.ListTagsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/tags\x12o\n" +
+	"\rListProviders\x12 .content.v1.ListProvidersRequest\x1a!.content.v1.ListProvidersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/providers\x12h\n" +
+	"\vGetMetadata\x12\x1e.content.v1.GetMetadataRequest\x1a\x1f.content.v1.GetMetadataResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/metadata\x12\x86\x01\n" +
+	"\fListSyncRuns\x12\x1f.content.v1.ListSyncRunsRequest\x1a .content.v1.ListSyncRunsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/v1/providers/{provider_code}/sync-runs\x12k\n" +
 	"\n" +
-	"GetContent\x12\x1d.content.v1.GetContentRequest\x1a\x1e.content.v1.GetContentResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/contents/{id}\x12h\n" +
-	"\vGetMetadata\x12\x1e.content.v1.GetMetadataRequest\x1a\x1f.content.v1.GetMetadataResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/metadataBMZKgithub.com/mehmetymw/search-aggregation-service/backend/proto/gen;contentpbb\x06proto3"
+	"GetSyncRun\x12\x1d.content.v1.GetSyncRunRequest\x1a\x1e.content.v1.GetSyncRunResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/sync-runs/{id}2\xf3\b\n" +
+	"\x14ProviderAdminService\x12z\n" +
+	"\x0eCreateProvider\x12!.content.v1.CreateProviderRequest\x1a!.content.v1.ProviderAdminResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/admin/providers\x12\x81\x01\n" +
+	"\x0eUpdateProvider\x12!.content.v1.UpdateProviderRequest\x1a!.content.v1.ProviderAdminResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/api/v1/admin/providers/{code}\x12\x91\x01\n" +
+	"\x12SetProviderEnabled\x12%.content.v1.SetProviderEnabledRequest\x1a!.content.v1.ProviderAdminResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/admin/providers/{code}/enabled\x12\x7f\n" +
+	"\x0eDeleteProvider\x12!.content.v1.DeleteProviderRequest\x1a\".content.v1.DeleteProviderResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/admin/providers/{code}\x12m\n" +
+	"\vTriggerSync\x12\x1e.content.v1.TriggerSyncRequest\x1a\x1f.content.v1.TriggerSyncResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/admin/sync\x12\x9b\x01\n" +
+	"\x16PreviewProviderMapping\x12).content.v1.PreviewProviderMappingRequest\x1a*.content.v1.PreviewProviderMappingResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/providers/preview\x12\x8e\x01\n" +
+	"\x13ListCircuitBreakers\x12&.content.v1.ListCircuitBreakersRequest\x1a'.content.v1.ListCircuitBreakersResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/admin/circuit-breakers\x12\xa7\x01\n" +
+	"\x13ResetCircuitBreaker\x12&.content.v1.ResetCircuitBreakerRequest\x1a'.content.v1.ResetCircuitBreakerResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/api/v1/admin/providers/{code}/circuit-breaker/resetBMZKgithub.com/mehmetymw/search-aggregation-service/backend/proto/gen;contentpbb\x06proto3"
 
 var (
 	file_proto_content_proto_rawDescOnce sync.Once
@@ -652,36 +3704,155 @@ func file_proto_content_proto_rawDescGZIP() []byte {
 	return file_proto_content_proto_rawDescData
 }
 
-var file_proto_content_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_content_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_proto_content_proto_goTypes = []any{
-	(*SearchRequest)(nil),       // 0: content.v1.SearchRequest
-	(*SearchResponse)(nil),      // 1: content.v1.SearchResponse
-	(*GetContentRequest)(nil),   // 2: content.v1.GetContentRequest
-	(*GetContentResponse)(nil),  // 3: content.v1.GetContentResponse
-	(*GetMetadataRequest)(nil),  // 4: content.v1.GetMetadataRequest
-	(*GetMetadataResponse)(nil), // 5: content.v1.GetMetadataResponse
-	(*ContentTypeMetadata)(nil), // 6: content.v1.ContentTypeMetadata
-	(*SortOptionMetadata)(nil),  // 7: content.v1.SortOptionMetadata
-	(*PaginationMetadata)(nil),  // 8: content.v1.PaginationMetadata
-	(*ContentItem)(nil),         // 9: content.v1.ContentItem
+	(*SearchRequest)(nil),                  // 0: content.v1.SearchRequest
+	(*SearchResponse)(nil),                 // 1: content.v1.SearchResponse
+	(*Facets)(nil),                         // 2: content.v1.Facets
+	(*FacetValue)(nil),                     // 3: content.v1.FacetValue
+	(*SuggestRequest)(nil),                 // 4: content.v1.SuggestRequest
+	(*Suggestion)(nil),                     // 5: content.v1.Suggestion
+	(*SuggestResponse)(nil),                // 6: content.v1.SuggestResponse
+	(*GetContentRequest)(nil),              // 7: content.v1.GetContentRequest
+	(*GetContentResponse)(nil),             // 8: content.v1.GetContentResponse
+	(*GetContentRawPayloadRequest)(nil),    // 9: content.v1.GetContentRawPayloadRequest
+	(*GetContentRawPayloadResponse)(nil),   // 10: content.v1.GetContentRawPayloadResponse
+	(*GetMetadataRequest)(nil),             // 11: content.v1.GetMetadataRequest
+	(*GetMetadataResponse)(nil),            // 12: content.v1.GetMetadataResponse
+	(*ContentTypeMetadata)(nil),            // 13: content.v1.ContentTypeMetadata
+	(*SortOptionMetadata)(nil),             // 14: content.v1.SortOptionMetadata
+	(*PaginationMetadata)(nil),             // 15: content.v1.PaginationMetadata
+	(*ContentItem)(nil),                    // 16: content.v1.ContentItem
+	(*Provider)(nil),                       // 17: content.v1.Provider
+	(*SyncSchedule)(nil),                   // 18: content.v1.SyncSchedule
+	(*FeedPagination)(nil),                 // 19: content.v1.FeedPagination
+	(*FeedMapping)(nil),                    // 20: content.v1.FeedMapping
+	(*FeedAuth)(nil),                       // 21: content.v1.FeedAuth
+	(*FieldMapping)(nil),                   // 22: content.v1.FieldMapping
+	(*ValueTransform)(nil),                 // 23: content.v1.ValueTransform
+	(*ListProvidersRequest)(nil),           // 24: content.v1.ListProvidersRequest
+	(*ListProvidersResponse)(nil),          // 25: content.v1.ListProvidersResponse
+	(*ListTagsRequest)(nil),                // 26: content.v1.ListTagsRequest
+	(*TagCount)(nil),                       // 27: content.v1.TagCount
+	(*ListTagsResponse)(nil),               // 28: content.v1.ListTagsResponse
+	(*CreateProviderRequest)(nil),          // 29: content.v1.CreateProviderRequest
+	(*UpdateProviderRequest)(nil),          // 30: content.v1.UpdateProviderRequest
+	(*SetProviderEnabledRequest)(nil),      // 31: content.v1.SetProviderEnabledRequest
+	(*DeleteProviderRequest)(nil),          // 32: content.v1.DeleteProviderRequest
+	(*DeleteProviderResponse)(nil),         // 33: content.v1.DeleteProviderResponse
+	(*ProviderAdminResponse)(nil),          // 34: content.v1.ProviderAdminResponse
+	(*TriggerSyncRequest)(nil),             // 35: content.v1.TriggerSyncRequest
+	(*TriggerSyncResponse)(nil),            // 36: content.v1.TriggerSyncResponse
+	(*PreviewProviderMappingRequest)(nil),  // 37: content.v1.PreviewProviderMappingRequest
+	(*MappedItem)(nil),                     // 38: content.v1.MappedItem
+	(*PreviewProviderMappingResponse)(nil), // 39: content.v1.PreviewProviderMappingResponse
+	(*CircuitBreaker)(nil),                 // 40: content.v1.CircuitBreaker
+	(*ListCircuitBreakersRequest)(nil),     // 41: content.v1.ListCircuitBreakersRequest
+	(*ListCircuitBreakersResponse)(nil),    // 42: content.v1.ListCircuitBreakersResponse
+	(*ResetCircuitBreakerRequest)(nil),     // 43: content.v1.ResetCircuitBreakerRequest
+	(*ResetCircuitBreakerResponse)(nil),    // 44: content.v1.ResetCircuitBreakerResponse
+	(*ListSyncRunsRequest)(nil),            // 45: content.v1.ListSyncRunsRequest
+	(*ListSyncRunsResponse)(nil),           // 46: content.v1.ListSyncRunsResponse
+	(*GetSyncRunRequest)(nil),              // 47: content.v1.GetSyncRunRequest
+	(*GetSyncRunResponse)(nil),             // 48: content.v1.GetSyncRunResponse
+	(*SyncRun)(nil),                        // 49: content.v1.SyncRun
+	nil,                                    // 50: content.v1.FeedAuth.HeadersEntry
+	nil,                                    // 51: content.v1.FeedAuth.QueryEntry
+	nil,                                    // 52: content.v1.ValueTransform.ValuesEntry
+	(*structpb.Value)(nil),                 // 53: google.protobuf.Value
 }
 var file_proto_content_proto_depIdxs = []int32{
-	9, // 0: content.v1.SearchResponse.items:type_name -> content.v1.ContentItem
-	9, // 1: content.v1.GetContentResponse.content:type_name -> content.v1.ContentItem
-	6, // 2: content.v1.GetMetadataResponse.content_types:type_name -> content.v1.ContentTypeMetadata
-	7, // 3: content.v1.GetMetadataResponse.sort_options:type_name -> content.v1.SortOptionMetadata
-	8, // 4: content.v1.GetMetadataResponse.pagination:type_name -> content.v1.PaginationMetadata
-	0, // 5: content.v1.ContentService.SearchContents:input_type -> content.v1.SearchRequest
-	2, // 6: content.v1.ContentService.GetContent:input_type -> content.v1.GetContentRequest
-	4, // 7: content.v1.ContentService.GetMetadata:input_type -> content.v1.GetMetadataRequest
-	1, // 8: content.v1.ContentService.SearchContents:output_type -> content.v1.SearchResponse
-	3, // 9: content.v1.ContentService.GetContent:output_type -> content.v1.GetContentResponse
-	5, // 10: content.v1.ContentService.GetMetadata:output_type -> content.v1.GetMetadataResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	16, // 0: content.v1.SearchResponse.items:type_name -> content.v1.ContentItem
+	2,  // 1: content.v1.SearchResponse.facets:type_name -> content.v1.Facets
+	3,  // 2: content.v1.Facets.content_types:type_name -> content.v1.FacetValue
+	3,  // 3: content.v1.Facets.providers:type_name -> content.v1.FacetValue
+	3,  // 4: content.v1.Facets.tags:type_name -> content.v1.FacetValue
+	3,  // 5: content.v1.Facets.published_at:type_name -> content.v1.FacetValue
+	5,  // 6: content.v1.SuggestResponse.suggestions:type_name -> content.v1.Suggestion
+	16, // 7: content.v1.GetContentResponse.content:type_name -> content.v1.ContentItem
+	53, // 8: content.v1.GetContentRawPayloadResponse.payload:type_name -> google.protobuf.Value
+	13, // 9: content.v1.GetMetadataResponse.content_types:type_name -> content.v1.ContentTypeMetadata
+	14, // 10: content.v1.GetMetadataResponse.sort_options:type_name -> content.v1.SortOptionMetadata
+	15, // 11: content.v1.GetMetadataResponse.pagination:type_name -> content.v1.PaginationMetadata
+	17, // 12: content.v1.ContentItem.provider:type_name -> content.v1.Provider
+	18, // 13: content.v1.Provider.sync_schedule:type_name -> content.v1.SyncSchedule
+	19, // 14: content.v1.Provider.feed_pagination:type_name -> content.v1.FeedPagination
+	20, // 15: content.v1.Provider.feed_mapping:type_name -> content.v1.FeedMapping
+	21, // 16: content.v1.Provider.feed_auth:type_name -> content.v1.FeedAuth
+	22, // 17: content.v1.FeedMapping.id:type_name -> content.v1.FieldMapping
+	22, // 18: content.v1.FeedMapping.title:type_name -> content.v1.FieldMapping
+	22, // 19: content.v1.FeedMapping.type:type_name -> content.v1.FieldMapping
+	22, // 20: content.v1.FeedMapping.views:type_name -> content.v1.FieldMapping
+	22, // 21: content.v1.FeedMapping.likes:type_name -> content.v1.FieldMapping
+	22, // 22: content.v1.FeedMapping.duration:type_name -> content.v1.FieldMapping
+	22, // 23: content.v1.FeedMapping.reading_time:type_name -> content.v1.FieldMapping
+	22, // 24: content.v1.FeedMapping.reactions:type_name -> content.v1.FieldMapping
+	22, // 25: content.v1.FeedMapping.comments:type_name -> content.v1.FieldMapping
+	22, // 26: content.v1.FeedMapping.published_at:type_name -> content.v1.FieldMapping
+	22, // 27: content.v1.FeedMapping.tags:type_name -> content.v1.FieldMapping
+	50, // 28: content.v1.FeedAuth.headers:type_name -> content.v1.FeedAuth.HeadersEntry
+	51, // 29: content.v1.FeedAuth.query:type_name -> content.v1.FeedAuth.QueryEntry
+	23, // 30: content.v1.FieldMapping.transforms:type_name -> content.v1.ValueTransform
+	52, // 31: content.v1.ValueTransform.values:type_name -> content.v1.ValueTransform.ValuesEntry
+	17, // 32: content.v1.ListProvidersResponse.providers:type_name -> content.v1.Provider
+	27, // 33: content.v1.ListTagsResponse.tags:type_name -> content.v1.TagCount
+	18, // 34: content.v1.CreateProviderRequest.sync_schedule:type_name -> content.v1.SyncSchedule
+	19, // 35: content.v1.CreateProviderRequest.feed_pagination:type_name -> content.v1.FeedPagination
+	20, // 36: content.v1.CreateProviderRequest.feed_mapping:type_name -> content.v1.FeedMapping
+	21, // 37: content.v1.CreateProviderRequest.feed_auth:type_name -> content.v1.FeedAuth
+	18, // 38: content.v1.UpdateProviderRequest.sync_schedule:type_name -> content.v1.SyncSchedule
+	19, // 39: content.v1.UpdateProviderRequest.feed_pagination:type_name -> content.v1.FeedPagination
+	20, // 40: content.v1.UpdateProviderRequest.feed_mapping:type_name -> content.v1.FeedMapping
+	21, // 41: content.v1.UpdateProviderRequest.feed_auth:type_name -> content.v1.FeedAuth
+	17, // 42: content.v1.ProviderAdminResponse.provider:type_name -> content.v1.Provider
+	49, // 43: content.v1.TriggerSyncResponse.runs:type_name -> content.v1.SyncRun
+	19, // 44: content.v1.PreviewProviderMappingRequest.feed_pagination:type_name -> content.v1.FeedPagination
+	20, // 45: content.v1.PreviewProviderMappingRequest.feed_mapping:type_name -> content.v1.FeedMapping
+	21, // 46: content.v1.PreviewProviderMappingRequest.feed_auth:type_name -> content.v1.FeedAuth
+	38, // 47: content.v1.PreviewProviderMappingResponse.items:type_name -> content.v1.MappedItem
+	40, // 48: content.v1.ListCircuitBreakersResponse.circuit_breakers:type_name -> content.v1.CircuitBreaker
+	40, // 49: content.v1.ResetCircuitBreakerResponse.circuit_breaker:type_name -> content.v1.CircuitBreaker
+	49, // 50: content.v1.ListSyncRunsResponse.runs:type_name -> content.v1.SyncRun
+	49, // 51: content.v1.GetSyncRunResponse.run:type_name -> content.v1.SyncRun
+	0,  // 52: content.v1.ContentService.SearchContents:input_type -> content.v1.SearchRequest
+	4,  // 53: content.v1.ContentService.Suggest:input_type -> content.v1.SuggestRequest
+	7,  // 54: content.v1.ContentService.GetContent:input_type -> content.v1.GetContentRequest
+	9,  // 55: content.v1.ContentService.GetContentRawPayload:input_type -> content.v1.GetContentRawPayloadRequest
+	26, // 56: content.v1.ContentService.ListTags:input_type -> content.v1.ListTagsRequest
+	24, // 57: content.v1.ContentService.ListProviders:input_type -> content.v1.ListProvidersRequest
+	11, // 58: content.v1.ContentService.GetMetadata:input_type -> content.v1.GetMetadataRequest
+	45, // 59: content.v1.ContentService.ListSyncRuns:input_type -> content.v1.ListSyncRunsRequest
+	47, // 60: content.v1.ContentService.GetSyncRun:input_type -> content.v1.GetSyncRunRequest
+	29, // 61: content.v1.ProviderAdminService.CreateProvider:input_type -> content.v1.CreateProviderRequest
+	30, // 62: content.v1.ProviderAdminService.UpdateProvider:input_type -> content.v1.UpdateProviderRequest
+	31, // 63: content.v1.ProviderAdminService.SetProviderEnabled:input_type -> content.v1.SetProviderEnabledRequest
+	32, // 64: content.v1.ProviderAdminService.DeleteProvider:input_type -> content.v1.DeleteProviderRequest
+	35, // 65: content.v1.ProviderAdminService.TriggerSync:input_type -> content.v1.TriggerSyncRequest
+	37, // 66: content.v1.ProviderAdminService.PreviewProviderMapping:input_type -> content.v1.PreviewProviderMappingRequest
+	41, // 67: content.v1.ProviderAdminService.ListCircuitBreakers:input_type -> content.v1.ListCircuitBreakersRequest
+	43, // 68: content.v1.ProviderAdminService.ResetCircuitBreaker:input_type -> content.v1.ResetCircuitBreakerRequest
+	1,  // 69: content.v1.ContentService.SearchContents:output_type -> content.v1.SearchResponse
+	6,  // 70: content.v1.ContentService.Suggest:output_type -> content.v1.SuggestResponse
+	8,  // 71: content.v1.ContentService.GetContent:output_type -> content.v1.GetContentResponse
+	10, // 72: content.v1.ContentService.GetContentRawPayload:output_type -> content.v1.GetContentRawPayloadResponse
+	28, // 73: content.v1.ContentService.ListTags:output_type -> content.v1.ListTagsResponse
+	25, // 74: content.v1.ContentService.ListProviders:output_type -> content.v1.ListProvidersResponse
+	12, // 75: content.v1.ContentService.GetMetadata:output_type -> content.v1.GetMetadataResponse
+	46, // 76: content.v1.ContentService.ListSyncRuns:output_type -> content.v1.ListSyncRunsResponse
+	48, // 77: content.v1.ContentService.GetSyncRun:output_type -> content.v1.GetSyncRunResponse
+	34, // 78: content.v1.ProviderAdminService.CreateProvider:output_type -> content.v1.ProviderAdminResponse
+	34, // 79: content.v1.ProviderAdminService.UpdateProvider:output_type -> content.v1.ProviderAdminResponse
+	34, // 80: content.v1.ProviderAdminService.SetProviderEnabled:output_type -> content.v1.ProviderAdminResponse
+	33, // 81: content.v1.ProviderAdminService.DeleteProvider:output_type -> content.v1.DeleteProviderResponse
+	36, // 82: content.v1.ProviderAdminService.TriggerSync:output_type -> content.v1.TriggerSyncResponse
+	39, // 83: content.v1.ProviderAdminService.PreviewProviderMapping:output_type -> content.v1.PreviewProviderMappingResponse
+	42, // 84: content.v1.ProviderAdminService.ListCircuitBreakers:output_type -> content.v1.ListCircuitBreakersResponse
+	44, // 85: content.v1.ProviderAdminService.ResetCircuitBreaker:output_type -> content.v1.ResetCircuitBreakerResponse
+	69, // [69:86] is the sub-list for method output_type
+	52, // [52:69] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_proto_content_proto_init() }
@@ -695,9 +3866,9 @@ func file_proto_content_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_content_proto_rawDesc), len(file_proto_content_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   53,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_content_proto_goTypes,
 		DependencyIndexes: file_proto_content_proto_depIdxs,
@@ -20,9 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContentService_SearchContents_FullMethodName = "/content.v1.ContentService/SearchContents"
-	ContentService_GetContent_FullMethodName     = "/content.v1.ContentService/GetContent"
-	ContentService_GetMetadata_FullMethodName    = "/content.v1.ContentService/GetMetadata"
+	ContentService_SearchContents_FullMethodName       = "/content.v1.ContentService/SearchContents"
+	ContentService_Suggest_FullMethodName              = "/content.v1.ContentService/Suggest"
+	ContentService_GetContent_FullMethodName           = "/content.v1.ContentService/GetContent"
+	ContentService_GetContentRawPayload_FullMethodName = "/content.v1.ContentService/GetContentRawPayload"
+	ContentService_ListTags_FullMethodName             = "/content.v1.ContentService/ListTags"
+	ContentService_ListProviders_FullMethodName        = "/content.v1.ContentService/ListProviders"
+	ContentService_GetMetadata_FullMethodName          = "/content.v1.ContentService/GetMetadata"
+	ContentService_ListSyncRuns_FullMethodName         = "/content.v1.ContentService/ListSyncRuns"
+	ContentService_GetSyncRun_FullMethodName           = "/content.v1.ContentService/GetSyncRun"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -30,8 +36,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ContentServiceClient interface {
 	SearchContents(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
 	GetContent(ctx context.Context, in *GetContentRequest, opts ...grpc.CallOption) (*GetContentResponse, error)
+	GetContentRawPayload(ctx context.Context, in *GetContentRawPayloadRequest, opts ...grpc.CallOption) (*GetContentRawPayloadResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
 	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error)
+	GetSyncRun(ctx context.Context, in *GetSyncRunRequest, opts ...grpc.CallOption) (*GetSyncRunResponse, error)
 }
 
 type contentServiceClient struct {
@@ -52,6 +64,16 @@ func (c *contentServiceClient) SearchContents(ctx context.Context, in *SearchReq
 	return out, nil
 }
 
+func (c *contentServiceClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, ContentService_Suggest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contentServiceClient) GetContent(ctx context.Context, in *GetContentRequest, opts ...grpc.CallOption) (*GetContentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetContentResponse)
@@ -62,6 +84,36 @@ func (c *contentServiceClient) GetContent(ctx context.Context, in *GetContentReq
 	return out, nil
 }
 
+func (c *contentServiceClient) GetContentRawPayload(ctx context.Context, in *GetContentRawPayloadRequest, opts ...grpc.CallOption) (*GetContentRawPayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContentRawPayloadResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetContentRawPayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *contentServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMetadataResponse)
@@ -72,13 +124,39 @@ func (c *contentServiceClient) GetMetadata(ctx context.Context, in *GetMetadataR
 	return out, nil
 }
 
+func (c *contentServiceClient) ListSyncRuns(ctx context.Context, in *ListSyncRunsRequest, opts ...grpc.CallOption) (*ListSyncRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyncRunsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListSyncRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetSyncRun(ctx context.Context, in *GetSyncRunRequest, opts ...grpc.CallOption) (*GetSyncRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSyncRunResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetSyncRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
 type ContentServiceServer interface {
 	SearchContents(context.Context, *SearchRequest) (*SearchResponse, error)
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
 	GetContent(context.Context, *GetContentRequest) (*GetContentResponse, error)
+	GetContentRawPayload(context.Context, *GetContentRawPayloadRequest) (*GetContentRawPayloadResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
 	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error)
+	GetSyncRun(context.Context, *GetSyncRunRequest) (*GetSyncRunResponse, error)
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -92,12 +170,30 @@ type UnimplementedContentServiceServer struct{}
 func (UnimplementedContentServiceServer) SearchContents(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchContents not implemented")
 }
+func (UnimplementedContentServiceServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
 func (UnimplementedContentServiceServer) GetContent(context.Context, *GetContentRequest) (*GetContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
 }
+func (UnimplementedContentServiceServer) GetContentRawPayload(context.Context, *GetContentRawPayloadRequest) (*GetContentRawPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentRawPayload not implemented")
+}
+func (UnimplementedContentServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedContentServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
 func (UnimplementedContentServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
 }
+func (UnimplementedContentServiceServer) ListSyncRuns(context.Context, *ListSyncRunsRequest) (*ListSyncRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncRuns not implemented")
+}
+func (UnimplementedContentServiceServer) GetSyncRun(context.Context, *GetSyncRunRequest) (*GetSyncRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncRun not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -137,6 +233,24 @@ func _ContentService_SearchContents_Handler(srv any, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_Suggest_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_Suggest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContentService_GetContent_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
 	in := new(GetContentRequest)
 	if err := dec(in); err != nil {
@@ -155,6 +269,60 @@ func _ContentService_GetContent_Handler(srv any, ctx context.Context, dec func(a
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_GetContentRawPayload_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(GetContentRawPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetContentRawPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetContentRawPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).GetContentRawPayload(ctx, req.(*GetContentRawPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListTags_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListProviders_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ContentService_GetMetadata_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
 	in := new(GetMetadataRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +341,42 @@ func _ContentService_GetMetadata_Handler(srv any, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_ListSyncRuns_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ListSyncRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListSyncRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListSyncRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).ListSyncRuns(ctx, req.(*ListSyncRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetSyncRun_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(GetSyncRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetSyncRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetSyncRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ContentServiceServer).GetSyncRun(ctx, req.(*GetSyncRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -184,14 +388,426 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchContents",
 			Handler:    _ContentService_SearchContents_Handler,
 		},
+		{
+			MethodName: "Suggest",
+			Handler:    _ContentService_Suggest_Handler,
+		},
 		{
 			MethodName: "GetContent",
 			Handler:    _ContentService_GetContent_Handler,
 		},
+		{
+			MethodName: "GetContentRawPayload",
+			Handler:    _ContentService_GetContentRawPayload_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ContentService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _ContentService_ListProviders_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _ContentService_GetMetadata_Handler,
 		},
+		{
+			MethodName: "ListSyncRuns",
+			Handler:    _ContentService_ListSyncRuns_Handler,
+		},
+		{
+			MethodName: "GetSyncRun",
+			Handler:    _ContentService_GetSyncRun_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/content.proto",
+}
+
+const (
+	ProviderAdminService_CreateProvider_FullMethodName         = "/content.v1.ProviderAdminService/CreateProvider"
+	ProviderAdminService_UpdateProvider_FullMethodName         = "/content.v1.ProviderAdminService/UpdateProvider"
+	ProviderAdminService_SetProviderEnabled_FullMethodName     = "/content.v1.ProviderAdminService/SetProviderEnabled"
+	ProviderAdminService_DeleteProvider_FullMethodName         = "/content.v1.ProviderAdminService/DeleteProvider"
+	ProviderAdminService_TriggerSync_FullMethodName            = "/content.v1.ProviderAdminService/TriggerSync"
+	ProviderAdminService_PreviewProviderMapping_FullMethodName = "/content.v1.ProviderAdminService/PreviewProviderMapping"
+	ProviderAdminService_ListCircuitBreakers_FullMethodName    = "/content.v1.ProviderAdminService/ListCircuitBreakers"
+	ProviderAdminService_ResetCircuitBreaker_FullMethodName    = "/content.v1.ProviderAdminService/ResetCircuitBreaker"
+)
+
+// ProviderAdminServiceClient is the client API for ProviderAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ProviderAdminService manages providers at runtime. Calls must carry
+// "authorization: Bearer <admin api key>".
+type ProviderAdminServiceClient interface {
+	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error)
+	UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error)
+	SetProviderEnabled(ctx context.Context, in *SetProviderEnabledRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error)
+	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
+	// TriggerSync starts syncs right away and returns their runs, which can be
+	// polled with GetSyncRun.
+	TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*TriggerSyncResponse, error)
+	// PreviewProviderMapping fetches the first page of a feed and returns its
+	// first items as the feed mapping reads them. Nothing is saved.
+	PreviewProviderMapping(ctx context.Context, in *PreviewProviderMappingRequest, opts ...grpc.CallOption) (*PreviewProviderMappingResponse, error)
+	// ListCircuitBreakers returns the circuit breaker state of every provider.
+	ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error)
+	// ResetCircuitBreaker closes a provider's circuit breaker and clears its
+	// counts.
+	ResetCircuitBreaker(ctx context.Context, in *ResetCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetCircuitBreakerResponse, error)
+}
+
+type providerAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderAdminServiceClient(cc grpc.ClientConnInterface) ProviderAdminServiceClient {
+	return &providerAdminServiceClient{cc}
+}
+
+func (c *providerAdminServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAdminResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_CreateProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAdminResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_UpdateProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) SetProviderEnabled(ctx context.Context, in *SetProviderEnabledRequest, opts ...grpc.CallOption) (*ProviderAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAdminResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_SetProviderEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProviderResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_DeleteProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*TriggerSyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerSyncResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_TriggerSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) PreviewProviderMapping(ctx context.Context, in *PreviewProviderMappingRequest, opts ...grpc.CallOption) (*PreviewProviderMappingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewProviderMappingResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_PreviewProviderMapping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_ListCircuitBreakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAdminServiceClient) ResetCircuitBreaker(ctx context.Context, in *ResetCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, ProviderAdminService_ResetCircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderAdminServiceServer is the server API for ProviderAdminService service.
+// All implementations must embed UnimplementedProviderAdminServiceServer
+// for forward compatibility.
+//
+// ProviderAdminService manages providers at runtime. Calls must carry
+// "authorization: Bearer <admin api key>".
+type ProviderAdminServiceServer interface {
+	CreateProvider(context.Context, *CreateProviderRequest) (*ProviderAdminResponse, error)
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*ProviderAdminResponse, error)
+	SetProviderEnabled(context.Context, *SetProviderEnabledRequest) (*ProviderAdminResponse, error)
+	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
+	// TriggerSync starts syncs right away and returns their runs, which can be
+	// polled with GetSyncRun.
+	TriggerSync(context.Context, *TriggerSyncRequest) (*TriggerSyncResponse, error)
+	// PreviewProviderMapping fetches the first page of a feed and returns its
+	// first items as the feed mapping reads them. Nothing is saved.
+	PreviewProviderMapping(context.Context, *PreviewProviderMappingRequest) (*PreviewProviderMappingResponse, error)
+	// ListCircuitBreakers returns the circuit breaker state of every provider.
+	ListCircuitBreakers(context.Context, *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error)
+	// ResetCircuitBreaker closes a provider's circuit breaker and clears its
+	// counts.
+	ResetCircuitBreaker(context.Context, *ResetCircuitBreakerRequest) (*ResetCircuitBreakerResponse, error)
+	mustEmbedUnimplementedProviderAdminServiceServer()
+}
+
+// UnimplementedProviderAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProviderAdminServiceServer struct{}
+
+func (UnimplementedProviderAdminServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*ProviderAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProvider not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) UpdateProvider(context.Context, *UpdateProviderRequest) (*ProviderAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) SetProviderEnabled(context.Context, *SetProviderEnabledRequest) (*ProviderAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderEnabled not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProvider not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) TriggerSync(context.Context, *TriggerSyncRequest) (*TriggerSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerSync not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) PreviewProviderMapping(context.Context, *PreviewProviderMappingRequest) (*PreviewProviderMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewProviderMapping not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) ListCircuitBreakers(context.Context, *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCircuitBreakers not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) ResetCircuitBreaker(context.Context, *ResetCircuitBreakerRequest) (*ResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (UnimplementedProviderAdminServiceServer) mustEmbedUnimplementedProviderAdminServiceServer() {}
+func (UnimplementedProviderAdminServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeProviderAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProviderAdminServiceServer will
+// result in compilation errors.
+type UnsafeProviderAdminServiceServer interface {
+	mustEmbedUnimplementedProviderAdminServiceServer()
+}
+
+func RegisterProviderAdminServiceServer(s grpc.ServiceRegistrar, srv ProviderAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProviderAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProviderAdminService_ServiceDesc, srv)
+}
+
+func _ProviderAdminService_CreateProvider_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(CreateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).CreateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_CreateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).CreateProvider(ctx, req.(*CreateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_UpdateProvider_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(UpdateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_UpdateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).UpdateProvider(ctx, req.(*UpdateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_SetProviderEnabled_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(SetProviderEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).SetProviderEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_SetProviderEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).SetProviderEnabled(ctx, req.(*SetProviderEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_DeleteProvider_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(DeleteProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).DeleteProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_DeleteProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).DeleteProvider(ctx, req.(*DeleteProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_TriggerSync_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(TriggerSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).TriggerSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_TriggerSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).TriggerSync(ctx, req.(*TriggerSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_PreviewProviderMapping_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(PreviewProviderMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).PreviewProviderMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_PreviewProviderMapping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).PreviewProviderMapping(ctx, req.(*PreviewProviderMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_ListCircuitBreakers_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ListCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).ListCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_ListCircuitBreakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).ListCircuitBreakers(ctx, req.(*ListCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAdminService_ResetCircuitBreaker_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ResetCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAdminServiceServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAdminService_ResetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(ProviderAdminServiceServer).ResetCircuitBreaker(ctx, req.(*ResetCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProviderAdminService_ServiceDesc is the grpc.ServiceDesc for ProviderAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProviderAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content.v1.ProviderAdminService",
+	HandlerType: (*ProviderAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProvider",
+			Handler:    _ProviderAdminService_CreateProvider_Handler,
+		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _ProviderAdminService_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "SetProviderEnabled",
+			Handler:    _ProviderAdminService_SetProviderEnabled_Handler,
+		},
+		{
+			MethodName: "DeleteProvider",
+			Handler:    _ProviderAdminService_DeleteProvider_Handler,
+		},
+		{
+			MethodName: "TriggerSync",
+			Handler:    _ProviderAdminService_TriggerSync_Handler,
+		},
+		{
+			MethodName: "PreviewProviderMapping",
+			Handler:    _ProviderAdminService_PreviewProviderMapping_Handler,
+		},
+		{
+			MethodName: "ListCircuitBreakers",
+			Handler:    _ProviderAdminService_ListCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _ProviderAdminService_ResetCircuitBreaker_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/content.proto",
@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
@@ -15,14 +17,32 @@ type MockContentRepository struct {
 	mock.Mock
 }
 
-func (m *MockContentRepository) SaveOrUpdateContents(ctx context.Context, contents []entity.Content) error {
+func (m *MockContentRepository) SaveOrUpdateContents(ctx context.Context, contents []entity.Content) (map[string]int64, error) {
 	args := m.Called(ctx, contents)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
 }
 
-func (m *MockContentRepository) SearchContents(ctx context.Context, filters ports.SearchFilters, pagination ports.Pagination) ([]entity.Content, int64, error) {
+func (m *MockContentRepository) SearchContents(ctx context.Context, filters ports.SearchFilters, pagination ports.Pagination) ([]ports.ContentMatch, int64, error) {
 	args := m.Called(ctx, filters, pagination)
-	return args.Get(0).([]entity.Content), args.Get(1).(int64), args.Error(2)
+	return args.Get(0).([]ports.ContentMatch), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockContentRepository) AutocompleteTitles(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error) {
+	args := m.Called(ctx, prefix, contentType, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockContentRepository) RefreshSearchDocuments(ctx context.Context, contentIDs []int64) error {
+	args := m.Called(ctx, contentIDs)
+	return args.Error(0)
+}
+
+func (m *MockContentRepository) SearchFacets(ctx context.Context, filters ports.SearchFilters, tagLimit int32) (entity.SearchFacets, error) {
+	args := m.Called(ctx, filters, tagLimit)
+	return args.Get(0).(entity.SearchFacets), args.Error(1)
 }
 
 func (m *MockContentRepository) GetByIDs(ctx context.Context, ids []int64) ([]entity.Content, error) {
@@ -38,6 +58,26 @@ func (m *MockContentRepository) GetByID(ctx context.Context, id int64) (*entity.
 	return args.Get(0).(*entity.Content), args.Error(1)
 }
 
+func (m *MockContentRepository) CountActiveByProvider(ctx context.Context, providerID int64) (int64, error) {
+	args := m.Called(ctx, providerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockContentRepository) ReconcileProviderContents(ctx context.Context, providerID int64, seenProviderContentIDs []string, opts ports.ReconcileOptions) (ports.ContentReconciliation, error) {
+	args := m.Called(ctx, providerID, seenProviderContentIDs, opts)
+	return args.Get(0).(ports.ContentReconciliation), args.Error(1)
+}
+
+func (m *MockContentRepository) GetContentHashes(ctx context.Context, providerID int64, providerContentIDs []string) (map[string]string, error) {
+	args := m.Called(ctx, providerID, providerContentIDs)
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+func (m *MockContentRepository) MarkSeen(ctx context.Context, providerID int64, providerContentIDs []string) error {
+	args := m.Called(ctx, providerID, providerContentIDs)
+	return args.Error(0)
+}
+
 // MockContentStatsRepository
 type MockContentStatsRepository struct {
 	mock.Mock
@@ -141,6 +181,11 @@ func (m *MockProviderRepository) GetAllEnabled(ctx context.Context) ([]entity.Pr
 	return args.Get(0).([]entity.Provider), args.Error(1)
 }
 
+func (m *MockProviderRepository) ListAll(ctx context.Context) ([]entity.Provider, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.Provider), args.Error(1)
+}
+
 func (m *MockProviderRepository) GetByCode(ctx context.Context, code string) (*entity.Provider, error) {
 	args := m.Called(ctx, code)
 	if args.Get(0) == nil {
@@ -162,6 +207,16 @@ func (m *MockProviderRepository) UpsertProvider(ctx context.Context, provider en
 	return args.Error(0)
 }
 
+func (m *MockProviderRepository) HasContents(ctx context.Context, id int64) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockProviderRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 // MockTagRepository
 type MockTagRepository struct {
 	mock.Mock
@@ -182,14 +237,77 @@ func (m *MockTagRepository) GetByContentID(ctx context.Context, contentID int64)
 	return args.Get(0).([]entity.Tag), args.Error(1)
 }
 
+func (m *MockTagRepository) GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64][]entity.Tag, error) {
+	args := m.Called(ctx, contentIDs)
+	return args.Get(0).(map[int64][]entity.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) ListWithCounts(ctx context.Context, contentType *entity.ContentType, limit int32) ([]entity.TagCount, error) {
+	args := m.Called(ctx, contentType, limit)
+	return args.Get(0).([]entity.TagCount), args.Error(1)
+}
+
+func (m *MockTagRepository) AutocompleteTags(ctx context.Context, prefix string, contentType *entity.ContentType, limit int32) ([]string, error) {
+	args := m.Called(ctx, prefix, contentType, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 // MockProviderClient
 type MockProviderClient struct {
 	mock.Mock
 }
 
-func (m *MockProviderClient) FetchContents(ctx context.Context, provider entity.Provider) ([]ports.ProviderContentItem, error) {
-	args := m.Called(ctx, provider)
-	return args.Get(0).([]ports.ProviderContentItem), args.Error(1)
+// FetchContents hands each stubbed page, a [][]ports.ProviderContentItem,
+// to handle before returning the stubbed result and error.
+func (m *MockProviderClient) FetchContents(ctx context.Context, provider entity.Provider, validators entity.FeedValidators, handle ports.PageHandler) (*ports.FetchResult, error) {
+	args := m.Called(ctx, provider, validators)
+	if pages, ok := args.Get(0).([][]ports.ProviderContentItem); ok {
+		for _, page := range pages {
+			if err := handle(ctx, mockContentItems(page)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if args.Get(1) == nil {
+		return nil, args.Error(2)
+	}
+	return args.Get(1).(*ports.FetchResult), args.Error(2)
+}
+
+// ProviderClients is a ports.ProviderClientRegistry over a fixed map.
+type ProviderClients map[string]ports.ProviderClient
+
+func (c ProviderClients) Client(format string) (ports.ProviderClient, bool) {
+	client, ok := c[format]
+	return client, ok
+}
+
+func (c ProviderClients) Formats() []string {
+	return slices.Sorted(maps.Keys(c))
+}
+
+func mockContentItems(items []ports.ProviderContentItem) ports.ContentItems {
+	return func(yield func(ports.ProviderContentItem, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// MockCircuitBreakers
+type MockCircuitBreakers struct {
+	mock.Mock
+}
+
+func (m *MockCircuitBreakers) State(providerCode string) ports.CircuitBreakerState {
+	args := m.Called(providerCode)
+	return args.Get(0).(ports.CircuitBreakerState)
+}
+
+func (m *MockCircuitBreakers) Reset(providerCode string) {
+	m.Called(providerCode)
 }
 
 // MockMetadataRepository
@@ -204,3 +322,153 @@ func (m *MockMetadataRepository) GetContentTypeMetadata(ctx context.Context) ([]
 	}
 	return args.Get(0).([]*contentpb.ContentTypeMetadata), args.Error(1)
 }
+
+func (m *MockMetadataRepository) GetProviderFormats(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockSyncRunRepository
+type MockSyncRunRepository struct {
+	mock.Mock
+}
+
+func (m *MockSyncRunRepository) Create(ctx context.Context, run entity.SyncRun) (int64, error) {
+	args := m.Called(ctx, run)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSyncRunRepository) Update(ctx context.Context, run entity.SyncRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+
+func (m *MockSyncRunRepository) ListByProvider(ctx context.Context, providerID int64, limit int32) ([]entity.SyncRun, error) {
+	args := m.Called(ctx, providerID, limit)
+	return args.Get(0).([]entity.SyncRun), args.Error(1)
+}
+
+func (m *MockSyncRunRepository) GetByID(ctx context.Context, id int64) (*entity.SyncRun, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SyncRun), args.Error(1)
+}
+
+// MockContentRawPayloadRepository
+type MockContentRawPayloadRepository struct {
+	mock.Mock
+}
+
+func (m *MockContentRawPayloadRepository) SaveOrUpdatePayloads(ctx context.Context, payloads []entity.ContentRawPayload) error {
+	args := m.Called(ctx, payloads)
+	return args.Error(0)
+}
+
+func (m *MockContentRawPayloadRepository) GetByContentID(ctx context.Context, contentID int64) (*entity.ContentRawPayload, error) {
+	args := m.Called(ctx, contentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ContentRawPayload), args.Error(1)
+}
+
+// MockFeedStateRepository
+type MockFeedStateRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeedStateRepository) Get(ctx context.Context, providerID int64) (*entity.FeedState, error) {
+	args := m.Called(ctx, providerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.FeedState), args.Error(1)
+}
+
+func (m *MockFeedStateRepository) Save(ctx context.Context, state entity.FeedState) error {
+	args := m.Called(ctx, state)
+	return args.Error(0)
+}
+
+// MockTransactor runs fn with the caller's context unless an error is stubbed.
+type MockTransactor struct {
+	mock.Mock
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(ctx)
+}
+
+// MockLocker
+type MockLocker struct {
+	mock.Mock
+}
+
+func (m *MockLocker) TryLock(ctx context.Context, key string) (ports.Lock, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(ports.Lock), args.Error(1)
+}
+
+// MockLock
+type MockLock struct {
+	mock.Mock
+}
+
+func (m *MockLock) Check(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockLock) Release(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// MockContentScoreRepository
+type MockContentScoreRepository struct {
+	mock.Mock
+}
+
+func (m *MockContentScoreRepository) SaveOrUpdateScores(ctx context.Context, scores []entity.ContentScore) error {
+	args := m.Called(ctx, scores)
+	return args.Error(0)
+}
+
+func (m *MockContentScoreRepository) GetByContentIDs(ctx context.Context, contentIDs []int64) (map[int64]entity.ContentScore, error) {
+	args := m.Called(ctx, contentIDs)
+	return args.Get(0).(map[int64]entity.ContentScore), args.Error(1)
+}
+
+func (m *MockContentScoreRepository) ListForScoring(ctx context.Context, afterID int64, limit int32) ([]ports.ContentWithStats, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).([]ports.ContentWithStats), args.Error(1)
+}
+
+// MockSearchTermRepository
+type MockSearchTermRepository struct {
+	mock.Mock
+}
+
+func (m *MockSearchTermRepository) SuggestTerms(ctx context.Context, word string, limit int32) ([]string, error) {
+	args := m.Called(ctx, word, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockScoringConfigProvider
+type MockScoringConfigProvider struct {
+	mock.Mock
+}
+
+func (m *MockScoringConfigProvider) GetScoringConfig() entity.ScoringConfig {
+	args := m.Called()
+	return args.Get(0).(entity.ScoringConfig)
+}
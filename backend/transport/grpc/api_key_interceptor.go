@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyInterceptor requires a bearer API key on every method of the
+// services it guards and lets other calls through.
+type APIKeyInterceptor struct {
+	apiKey   string
+	services []string
+}
+
+func NewAPIKeyInterceptor(apiKey string, services ...string) *APIKeyInterceptor {
+	return &APIKeyInterceptor{
+		apiKey:   apiKey,
+		services: services,
+	}
+}
+
+func (i *APIKeyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !i.guards(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if i.apiKey == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin api is disabled")
+		}
+		if !i.authorized(ctx) {
+			return nil, status.Error(codes.Unauthenticated, "invalid or missing api key")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *APIKeyInterceptor) guards(fullMethod string) bool {
+	for _, service := range i.services {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *APIKeyInterceptor) authorized(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get("authorization") {
+		key, found := strings.CutPrefix(value, "Bearer ")
+		if found && subtle.ConstantTimeCompare([]byte(key), []byte(i.apiKey)) == 1 {
+			return true
+		}
+	}
+	return false
+}
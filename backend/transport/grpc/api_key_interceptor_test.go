@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPIKeyInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	adminMethod := &grpc.UnaryServerInfo{FullMethod: "/content.v1.ProviderAdminService/CreateProvider"}
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+key))
+	}
+
+	interceptor := NewAPIKeyInterceptor("secret", "content.v1.ProviderAdminService").Unary()
+
+	t.Run("Public Method", func(t *testing.T) {
+		resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/content.v1.ContentService/SearchContents"}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Valid Key", func(t *testing.T) {
+		resp, err := interceptor(withKey("secret"), nil, adminMethod, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("Wrong Key", func(t *testing.T) {
+		_, err := interceptor(withKey("guess"), nil, adminMethod, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Missing Key", func(t *testing.T) {
+		_, err := interceptor(context.Background(), nil, adminMethod, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Disabled Without Key", func(t *testing.T) {
+		disabled := NewAPIKeyInterceptor("", "content.v1.ProviderAdminService").Unary()
+		_, err := disabled(withKey(""), nil, adminMethod, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,30 +12,51 @@ import (
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
 	contentpb "github.com/mehmetymw/search-aggregation-service/backend/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type ContentServiceServer struct {
 	contentpb.UnimplementedContentServiceServer
-	searchUseCase  *usecase.SearchContentsUseCase
-	getByIDUseCase *usecase.GetContentByIDUseCase
-	metadataRepo   ports.MetadataRepository
-	logger         ports.Logger
-	appConfig      entity.AppConfig
+	searchUseCase        *usecase.SearchContentsUseCase
+	autocompleteUseCase  *usecase.AutocompleteUseCase
+	getByIDUseCase       *usecase.GetContentByIDUseCase
+	listSyncRunsUseCase  *usecase.ListSyncRunsUseCase
+	getSyncRunUseCase    *usecase.GetSyncRunUseCase
+	rawPayloadUseCase    *usecase.GetContentRawPayloadUseCase
+	listTagsUseCase      *usecase.ListTagsUseCase
+	listProvidersUseCase *usecase.ListProvidersUseCase
+	metadataRepo         ports.MetadataRepository
+	logger               ports.Logger
+	appConfig            entity.AppConfig
 }
 
 func NewContentServiceServer(
 	searchUseCase *usecase.SearchContentsUseCase,
+	autocompleteUseCase *usecase.AutocompleteUseCase,
 	getByIDUseCase *usecase.GetContentByIDUseCase,
+	listSyncRunsUseCase *usecase.ListSyncRunsUseCase,
+	getSyncRunUseCase *usecase.GetSyncRunUseCase,
+	rawPayloadUseCase *usecase.GetContentRawPayloadUseCase,
+	listTagsUseCase *usecase.ListTagsUseCase,
+	listProvidersUseCase *usecase.ListProvidersUseCase,
 	metadataRepo ports.MetadataRepository,
 	appConfig entity.AppConfig,
 	logger ports.Logger,
 ) *ContentServiceServer {
 	return &ContentServiceServer{
-		searchUseCase:  searchUseCase,
-		getByIDUseCase: getByIDUseCase,
-		metadataRepo:   metadataRepo,
-		appConfig:      appConfig,
-		logger:         logger,
+		searchUseCase:        searchUseCase,
+		autocompleteUseCase:  autocompleteUseCase,
+		getByIDUseCase:       getByIDUseCase,
+		listSyncRunsUseCase:  listSyncRunsUseCase,
+		getSyncRunUseCase:    getSyncRunUseCase,
+		rawPayloadUseCase:    rawPayloadUseCase,
+		listTagsUseCase:      listTagsUseCase,
+		listProvidersUseCase: listProvidersUseCase,
+		metadataRepo:         metadataRepo,
+		appConfig:            appConfig,
+		logger:               logger,
 	}
 }
 
@@ -77,15 +100,43 @@ func (s *ContentServiceServer) SearchContents(ctx context.Context, req *contentp
 		sortOption = usecase.SortScoreDesc
 	}
 
+	tagMatch := usecase.TagMatchMode(req.TagMatch)
+	switch tagMatch {
+	case "":
+		tagMatch = usecase.TagMatchAny
+	case usecase.TagMatchAny, usecase.TagMatchAll:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "tag_match must be %q or %q", usecase.TagMatchAny, usecase.TagMatchAll)
+	}
+
+	publishedAfter, err := parseTimeParam("published_after", req.PublishedAfter)
+	if err != nil {
+		return nil, err
+	}
+	publishedBefore, err := parseTimeParam("published_before", req.PublishedBefore)
+	if err != nil {
+		return nil, err
+	}
+
 	useCaseReq := usecase.SearchContentsRequest{
-		Query:       req.Query,
-		ContentType: contentType,
-		Sort:        sortOption,
-		Page:        page,
-		PageSize:    pageSize,
+		Query:           req.Query,
+		ContentType:     contentType,
+		IncludeTags:     req.Tags,
+		ExcludeTags:     req.ExcludeTags,
+		ProviderCodes:   req.Providers,
+		TagMatch:        tagMatch,
+		PublishedAfter:  publishedAfter,
+		PublishedBefore: publishedBefore,
+		Sort:            sortOption,
+		Page:            page,
+		PageSize:        pageSize,
+		IncludeFacets:   req.IncludeFacets,
 	}
 
 	result, err := s.searchUseCase.Execute(ctx, useCaseReq)
+	if errors.Is(err, usecase.ErrProviderNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		s.logger.Error("search failed", loggerPkg.Error(err))
 		return nil, fmt.Errorf("search: %w", err)
@@ -97,13 +148,43 @@ func (s *ContentServiceServer) SearchContents(ctx context.Context, req *contentp
 	}
 
 	return &contentpb.SearchResponse{
-		Items:    items,
-		Page:     result.Page,
-		PageSize: result.PageSize,
-		Total:    result.Total,
+		Items:       items,
+		Page:        result.Page,
+		PageSize:    result.PageSize,
+		Total:       result.Total,
+		Suggestions: result.Suggestions,
+		Facets:      toProtoFacets(result.Facets),
 	}, nil
 }
 
+func (s *ContentServiceServer) Suggest(ctx context.Context, req *contentpb.SuggestRequest) (*contentpb.SuggestResponse, error) {
+	var contentType *entity.ContentType
+	if req.Type != "" && req.Type != "all" {
+		ct := entity.ContentType(req.Type)
+		contentType = &ct
+	}
+
+	completions, err := s.autocompleteUseCase.Execute(ctx, usecase.AutocompleteRequest{
+		Prefix:      req.Prefix,
+		ContentType: contentType,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		s.logger.Error("suggest failed", loggerPkg.String("prefix", req.Prefix), loggerPkg.Error(err))
+		return nil, fmt.Errorf("suggest: %w", err)
+	}
+
+	suggestions := make([]*contentpb.Suggestion, 0, len(completions))
+	for _, completion := range completions {
+		suggestions = append(suggestions, &contentpb.Suggestion{
+			Text: completion.Text,
+			Kind: string(completion.Kind),
+		})
+	}
+
+	return &contentpb.SuggestResponse{Suggestions: suggestions}, nil
+}
+
 func (s *ContentServiceServer) GetContent(ctx context.Context, req *contentpb.GetContentRequest) (*contentpb.GetContentResponse, error) {
 	useCaseReq := usecase.GetContentByIDRequest{
 		ID: req.Id,
@@ -124,6 +205,77 @@ func (s *ContentServiceServer) GetContent(ctx context.Context, req *contentpb.Ge
 	}, nil
 }
 
+func (s *ContentServiceServer) GetContentRawPayload(ctx context.Context, req *contentpb.GetContentRawPayloadRequest) (*contentpb.GetContentRawPayloadResponse, error) {
+	result, err := s.rawPayloadUseCase.Execute(ctx, usecase.GetContentRawPayloadRequest{ContentID: req.Id})
+	if err != nil {
+		s.logger.Error("get raw payload failed", loggerPkg.Int64("id", req.Id), loggerPkg.Error(err))
+		return nil, fmt.Errorf("get raw payload: %w", err)
+	}
+
+	if result == nil {
+		return &contentpb.GetContentRawPayloadResponse{}, nil
+	}
+
+	var decoded any
+	if err := json.Unmarshal(result.RawPayload, &decoded); err != nil {
+		return nil, fmt.Errorf("decode raw payload: %w", err)
+	}
+
+	payload, err := structpb.NewValue(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("convert raw payload: %w", err)
+	}
+
+	return &contentpb.GetContentRawPayloadResponse{
+		ContentId:  result.ContentID,
+		ProviderId: result.ProviderID,
+		FetchedAt:  result.FetchedAt.Format(time.RFC3339),
+		Payload:    payload,
+	}, nil
+}
+
+func (s *ContentServiceServer) ListTags(ctx context.Context, req *contentpb.ListTagsRequest) (*contentpb.ListTagsResponse, error) {
+	var contentType *entity.ContentType
+	if req.Type != "" && req.Type != "all" {
+		ct := entity.ContentType(req.Type)
+		contentType = &ct
+	}
+
+	tags, err := s.listTagsUseCase.Execute(ctx, usecase.ListTagsRequest{
+		ContentType: contentType,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		s.logger.Error("list tags failed", loggerPkg.Error(err))
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	items := make([]*contentpb.TagCount, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, &contentpb.TagCount{
+			Name:         tag.Tag.Name,
+			ContentCount: tag.ContentCount,
+		})
+	}
+
+	return &contentpb.ListTagsResponse{Tags: items}, nil
+}
+
+func (s *ContentServiceServer) ListProviders(ctx context.Context, req *contentpb.ListProvidersRequest) (*contentpb.ListProvidersResponse, error) {
+	providers, err := s.listProvidersUseCase.Execute(ctx)
+	if err != nil {
+		s.logger.Error("list providers failed", loggerPkg.Error(err))
+		return nil, fmt.Errorf("list providers: %w", err)
+	}
+
+	items := make([]*contentpb.Provider, 0, len(providers))
+	for _, provider := range providers {
+		items = append(items, toProtoProvider(provider))
+	}
+
+	return &contentpb.ListProvidersResponse{Providers: items}, nil
+}
+
 func (s *ContentServiceServer) GetMetadata(ctx context.Context, req *contentpb.GetMetadataRequest) (*contentpb.GetMetadataResponse, error) {
 	contentTypes, err := s.metadataRepo.GetContentTypeMetadata(ctx)
 	if err != nil {
@@ -141,13 +293,133 @@ func (s *ContentServiceServer) GetMetadata(ctx context.Context, req *contentpb.G
 	}, nil
 }
 
+func (s *ContentServiceServer) ListSyncRuns(ctx context.Context, req *contentpb.ListSyncRunsRequest) (*contentpb.ListSyncRunsResponse, error) {
+	result, err := s.listSyncRunsUseCase.Execute(ctx, usecase.ListSyncRunsRequest{
+		ProviderCode: req.ProviderCode,
+		Limit:        req.Limit,
+	})
+	if errors.Is(err, usecase.ErrProviderNotFound) {
+		return nil, status.Errorf(codes.NotFound, "provider %q not found", req.ProviderCode)
+	}
+	if err != nil {
+		s.logger.Error("list sync runs failed", loggerPkg.String("provider_code", req.ProviderCode), loggerPkg.Error(err))
+		return nil, fmt.Errorf("list sync runs: %w", err)
+	}
+
+	runs := make([]*contentpb.SyncRun, 0, len(result))
+	for _, run := range result {
+		runs = append(runs, toProtoSyncRun(run))
+	}
+
+	return &contentpb.ListSyncRunsResponse{
+		Runs: runs,
+	}, nil
+}
+
+func (s *ContentServiceServer) GetSyncRun(ctx context.Context, req *contentpb.GetSyncRunRequest) (*contentpb.GetSyncRunResponse, error) {
+	result, err := s.getSyncRunUseCase.Execute(ctx, usecase.GetSyncRunRequest{ID: req.Id})
+	if err != nil {
+		s.logger.Error("get sync run failed", loggerPkg.Int64("id", req.Id), loggerPkg.Error(err))
+		return nil, fmt.Errorf("get sync run: %w", err)
+	}
+
+	if result == nil {
+		return &contentpb.GetSyncRunResponse{}, nil
+	}
+
+	return &contentpb.GetSyncRunResponse{
+		Run: toProtoSyncRun(*result),
+	}, nil
+}
+
 func (s *ContentServiceServer) toProtoContentItem(item usecase.ContentWithScore) *contentpb.ContentItem {
-	return &contentpb.ContentItem{
-		Id:           item.Content.ID,
-		Title:        item.Content.Title,
-		ContentType:  string(item.Content.ContentType),
-		Score:        item.Score.FinalScore,
-		PublishedAt:  item.Content.PublishedAt.Format(time.RFC3339),
-		ProviderName: fmt.Sprintf("provider-%d", item.Content.ProviderID),
+	contentItem := &contentpb.ContentItem{
+		Id:          item.Content.ID,
+		Title:       item.Content.Title,
+		ContentType: string(item.Content.ContentType),
+		Score:       item.Score.FinalScore,
+		PublishedAt: item.Content.PublishedAt.Format(time.RFC3339),
+		Tags:        tagNames(item.Tags),
+	}
+	if item.Provider != nil {
+		contentItem.ProviderName = item.Provider.Name
+		contentItem.Provider = toProtoProvider(*item.Provider)
+	}
+	return contentItem
+}
+
+func toProtoProvider(provider entity.Provider) *contentpb.Provider {
+	return &contentpb.Provider{
+		Code:      provider.Code,
+		Name:      provider.Name,
+		Format:    provider.Format,
+		IsEnabled: provider.IsEnabled,
+	}
+}
+
+func toProtoFacets(facets *entity.SearchFacets) *contentpb.Facets {
+	if facets == nil {
+		return nil
+	}
+	return &contentpb.Facets{
+		ContentTypes: toProtoFacetValues(facets.ContentTypes),
+		Providers:    toProtoFacetValues(facets.Providers),
+		Tags:         toProtoFacetValues(facets.Tags),
+		PublishedAt:  toProtoFacetValues(facets.PublishedAt),
+	}
+}
+
+func toProtoFacetValues(values []entity.FacetValue) []*contentpb.FacetValue {
+	result := make([]*contentpb.FacetValue, 0, len(values))
+	for _, value := range values {
+		result = append(result, &contentpb.FacetValue{
+			Value: value.Value,
+			Label: value.Label,
+			Count: value.Count,
+		})
+	}
+	return result
+}
+
+func parseTimeParam(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC 3339 timestamp", name)
+	}
+	return &t, nil
+}
+
+func tagNames(tags []entity.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+func toProtoSyncRun(item usecase.SyncRunWithProvider) *contentpb.SyncRun {
+	run := &contentpb.SyncRun{
+		Id:               item.Run.ID,
+		ProviderId:       item.Run.ProviderID,
+		ProviderCode:     item.Provider.Code,
+		Status:           string(item.Run.Status),
+		StartedAt:        item.Run.StartedAt.Format(time.RFC3339),
+		ItemCount:        item.Run.ItemCount,
+		UpsertedCount:    item.Run.UpsertedCount,
+		StatsCount:       item.Run.StatsCount,
+		TagsCount:        item.Run.TagsCount,
+		SkippedCount:     item.Run.SkippedCount,
+		DeactivatedCount: item.Run.DeactivatedCount,
+		ReactivatedCount: item.Run.ReactivatedCount,
+		UnchangedCount:   item.Run.UnchangedCount,
+		NotModified:      item.Run.NotModified,
+		ErrorMessage:     item.Run.ErrorMessage,
+	}
+	if item.Run.FinishedAt != nil {
+		run.FinishedAt = item.Run.FinishedAt.Format(time.RFC3339)
 	}
+	return run
 }
@@ -7,15 +7,22 @@ import (
 
 	"github.com/mehmetymw/search-aggregation-service/backend/application/usecase"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
 	"github.com/mehmetymw/search-aggregation-service/backend/domain/service"
 	contentpb "github.com/mehmetymw/search-aggregation-service/backend/proto/gen"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestContentServiceServer_SearchContents(t *testing.T) {
 	mockContentRepo := new(MockContentRepository)
 	mockStatsRepo := new(MockContentStatsRepository)
+	mockScoreRepo := new(MockContentScoreRepository)
+	mockSearchTermRepo := new(MockSearchTermRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockProviderRepo := new(MockProviderRepository)
 	mockCache := new(MockCacheClient)
 	mockLogger := new(MockLogger)
 	mockMetadataRepo := new(MockMetadataRepository)
@@ -29,15 +36,23 @@ func TestContentServiceServer_SearchContents(t *testing.T) {
 	searchUC := usecase.NewSearchContentsUseCase(
 		mockContentRepo,
 		mockStatsRepo,
+		mockScoreRepo,
+		mockSearchTermRepo,
+		mockTagRepo,
+		mockProviderRepo,
 		mockCache,
 		scoringService,
+		service.NewTagNormalizer(),
 		mockLogger,
 		time.Minute,
+		entity.SearchConfig{},
 	)
 
 	getByIDUC := usecase.NewGetContentByIDUseCase(
 		mockContentRepo,
 		mockStatsRepo,
+		mockTagRepo,
+		mockProviderRepo,
 		scoringService,
 	)
 
@@ -51,7 +66,13 @@ func TestContentServiceServer_SearchContents(t *testing.T) {
 
 	server := NewContentServiceServer(
 		searchUC,
+		nil,
 		getByIDUC,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		mockMetadataRepo,
 		appConfig,
 		mockLogger,
@@ -70,16 +91,22 @@ func TestContentServiceServer_SearchContents(t *testing.T) {
 		mockCache.On("Get", ctx, mock.AnythingOfType("string"), mock.Anything).Return(false, nil)
 
 		// Mock Repo Search
-		contents := []entity.Content{
-			{ID: 1, Title: "Test Video", ContentType: entity.ContentTypeVideo},
+		matches := []ports.ContentMatch{
+			{Content: entity.Content{ID: 1, ProviderID: 3, Title: "Test Video", ContentType: entity.ContentTypeVideo}},
 		}
-		mockContentRepo.On("SearchContents", ctx, mock.Anything, mock.Anything).Return(contents, int64(1), nil)
+		mockContentRepo.On("SearchContents", ctx, mock.Anything, mock.Anything).Return(matches, int64(1), nil)
 
 		// Mock Repo Stats
 		stats := map[int64]entity.ContentStats{
 			1: {ContentID: 1, Views: 100},
 		}
 		mockStatsRepo.On("GetByContentIDs", ctx, []int64{1}).Return(stats, nil)
+		mockScoreRepo.On("GetByContentIDs", ctx, []int64{1}).Return(map[int64]entity.ContentScore{}, nil)
+		mockTagRepo.On("GetByContentIDs", ctx, []int64{1}).Return(map[int64][]entity.Tag{1: {{ID: 1, Name: "golang"}}}, nil)
+		mockProviderRepo.On("GetByID", ctx, int64(3)).Return(&entity.Provider{ID: 3, Code: "provider1", Name: "Provider One", Format: "json"}, nil)
+
+		// A single result is below the suggestion threshold.
+		mockSearchTermRepo.On("SuggestTerms", ctx, "test", int32(3)).Return([]string{"test", "tests"}, nil)
 
 		// Mock Cache Set
 		mockCache.On("Set", ctx, mock.AnythingOfType("string"), mock.Anything, time.Minute).Return(nil)
@@ -90,45 +117,253 @@ func TestContentServiceServer_SearchContents(t *testing.T) {
 		assert.Equal(t, int64(1), resp.Total)
 		assert.Len(t, resp.Items, 1)
 		assert.Equal(t, "Test Video", resp.Items[0].Title)
+		assert.Equal(t, []string{"golang"}, resp.Items[0].Tags)
+		assert.Equal(t, "Provider One", resp.Items[0].ProviderName)
+		assert.Equal(t, "provider1", resp.Items[0].Provider.Code)
+		assert.Equal(t, "json", resp.Items[0].Provider.Format)
+		assert.Empty(t, resp.Suggestions)
+	})
+
+	t.Run("Invalid Tag Match", func(t *testing.T) {
+		_, err := server.SearchContents(ctx, &contentpb.SearchRequest{Tags: []string{"go"}, TagMatch: "some"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Facets", func(t *testing.T) {
+		facets := entity.SearchFacets{
+			ContentTypes: []entity.FacetValue{{Value: "video", Label: "video", Count: 1}},
+			Providers:    []entity.FacetValue{{Value: "provider1", Label: "Provider One", Count: 1}},
+		}
+		mockContentRepo.On("SearchFacets", ctx, mock.Anything, int32(10)).Return(facets, nil).Once()
+
+		resp, err := server.SearchContents(ctx, &contentpb.SearchRequest{
+			Query:          "test",
+			IncludeFacets:  true,
+			PublishedAfter: "2024-01-01T00:00:00Z",
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Facets.ContentTypes, 1)
+		assert.Equal(t, "Provider One", resp.Facets.Providers[0].Label)
+		assert.Empty(t, resp.Facets.Tags)
+	})
+
+	t.Run("Invalid Published After", func(t *testing.T) {
+		_, err := server.SearchContents(ctx, &contentpb.SearchRequest{PublishedAfter: "yesterday"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := server.SearchContents(ctx, &contentpb.SearchRequest{Providers: []string{"missing"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
 	})
 }
 
+func TestContentServiceServer_Suggest(t *testing.T) {
+	mockContentRepo := new(MockContentRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockCache := new(MockCacheClient)
+	mockLogger := new(MockLogger)
+
+	server := &ContentServiceServer{
+		autocompleteUseCase: usecase.NewAutocompleteUseCase(mockContentRepo, mockTagRepo, mockCache, mockLogger, 30*time.Second),
+		logger:              mockLogger,
+	}
+
+	ctx := context.Background()
+	video := entity.ContentTypeVideo
+
+	mockCache.On("Get", ctx, "suggest:go:video:5", mock.Anything).Return(false, nil)
+	mockTagRepo.On("AutocompleteTags", ctx, "go", &video, int32(5)).Return([]string{"golang"}, nil)
+	mockContentRepo.On("AutocompleteTitles", ctx, "go", &video, int32(5)).Return([]string{"Go Concurrency Patterns"}, nil)
+	mockCache.On("Set", ctx, "suggest:go:video:5", mock.Anything, 30*time.Second).Return(nil)
+
+	resp, err := server.Suggest(ctx, &contentpb.SuggestRequest{Prefix: "Go", Type: "video", Limit: 5})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Suggestions, 2)
+	assert.Equal(t, "golang", resp.Suggestions[0].Text)
+	assert.Equal(t, "tag", resp.Suggestions[0].Kind)
+	assert.Equal(t, "Go Concurrency Patterns", resp.Suggestions[1].Text)
+	assert.Equal(t, "title", resp.Suggestions[1].Kind)
+}
+
 func TestContentServiceServer_GetContent(t *testing.T) {
 	mockContentRepo := new(MockContentRepository)
 	mockStatsRepo := new(MockContentStatsRepository)
+	mockTagRepo := new(MockTagRepository)
+	mockProviderRepo := new(MockProviderRepository)
 	mockLogger := new(MockLogger)
-	
+
 	// We need to setup the server similarly...
 	// For brevity, I'll just setup what's needed for GetContent
-	
+
 	scoringConfig := entity.ScoringConfig{}
 	timeProvider := func() time.Time { return time.Now() }
 	scoringService := service.NewScoringService(scoringConfig, timeProvider)
-	
+
 	getByIDUC := usecase.NewGetContentByIDUseCase(
 		mockContentRepo,
 		mockStatsRepo,
+		mockTagRepo,
+		mockProviderRepo,
 		scoringService,
 	)
-	
+
 	server := &ContentServiceServer{
 		getByIDUseCase: getByIDUC,
 		logger:         mockLogger,
 	}
-	
+
 	ctx := context.Background()
 	req := &contentpb.GetContentRequest{Id: 1}
-	
+
 	t.Run("Found", func(t *testing.T) {
 		content := &entity.Content{ID: 1, Title: "Found"}
 		mockContentRepo.On("GetByID", ctx, int64(1)).Return(content, nil)
-		
+		mockProviderRepo.On("GetByID", ctx, int64(0)).Return(nil, nil)
+
 		stats := &entity.ContentStats{ContentID: 1}
 		mockStatsRepo.On("GetByContentID", ctx, int64(1)).Return(stats, nil)
-		
+		mockTagRepo.On("GetByContentID", ctx, int64(1)).Return([]entity.Tag{{ID: 2, Name: "news"}}, nil)
+
 		resp, err := server.GetContent(ctx, req)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp.Content)
 		assert.Equal(t, "Found", resp.Content.Title)
+		assert.Equal(t, []string{"news"}, resp.Content.Tags)
+	})
+}
+
+func TestContentServiceServer_ListTags(t *testing.T) {
+	mockTagRepo := new(MockTagRepository)
+	mockLogger := new(MockLogger)
+
+	server := &ContentServiceServer{
+		listTagsUseCase: usecase.NewListTagsUseCase(mockTagRepo),
+		logger:          mockLogger,
+	}
+
+	ctx := context.Background()
+	article := entity.ContentTypeArticle
+	mockTagRepo.On("ListWithCounts", ctx, &article, int32(50)).Return([]entity.TagCount{
+		{Tag: entity.Tag{ID: 1, Name: "golang"}, ContentCount: 12},
+		{Tag: entity.Tag{ID: 2, Name: "testing"}, ContentCount: 4},
+	}, nil)
+
+	resp, err := server.ListTags(ctx, &contentpb.ListTagsRequest{Type: "article"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Tags, 2)
+	assert.Equal(t, "golang", resp.Tags[0].Name)
+	assert.Equal(t, int64(12), resp.Tags[0].ContentCount)
+}
+
+func TestContentServiceServer_ListProviders(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockLogger := new(MockLogger)
+
+	server := &ContentServiceServer{
+		listProvidersUseCase: usecase.NewListProvidersUseCase(mockProviderRepo),
+		logger:               mockLogger,
+	}
+
+	ctx := context.Background()
+	mockProviderRepo.On("ListAll", ctx).Return([]entity.Provider{
+		{ID: 1, Code: "provider1", Name: "Provider One", Format: entity.ProviderFormatJSON, IsEnabled: true},
+		{ID: 2, Code: "provider2", Name: "Provider Two", Format: entity.ProviderFormatXML},
+	}, nil)
+
+	resp, err := server.ListProviders(ctx, &contentpb.ListProvidersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Providers, 2)
+	assert.Equal(t, "provider1", resp.Providers[0].Code)
+	assert.True(t, resp.Providers[0].IsEnabled)
+	assert.Equal(t, "xml", resp.Providers[1].Format)
+	assert.False(t, resp.Providers[1].IsEnabled)
+}
+
+func TestContentServiceServer_ListSyncRuns(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockSyncRunRepo := new(MockSyncRunRepository)
+	mockLogger := new(MockLogger)
+
+	server := &ContentServiceServer{
+		listSyncRunsUseCase: usecase.NewListSyncRunsUseCase(mockProviderRepo, mockSyncRunRepo),
+		logger:              mockLogger,
+	}
+
+	ctx := context.Background()
+
+	t.Run("Found", func(t *testing.T) {
+		provider := &entity.Provider{ID: 1, Code: "json-provider"}
+		mockProviderRepo.On("GetByCode", ctx, "json-provider").Return(provider, nil)
+
+		finishedAt := time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC)
+		runs := []entity.SyncRun{
+			{
+				ID:            10,
+				ProviderID:    1,
+				StartedAt:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				FinishedAt:    &finishedAt,
+				Status:        entity.SyncRunStatusSuccess,
+				ItemCount:     5,
+				UpsertedCount: 5,
+			},
+		}
+		mockSyncRunRepo.On("ListByProvider", ctx, int64(1), int32(20)).Return(runs, nil)
+
+		resp, err := server.ListSyncRuns(ctx, &contentpb.ListSyncRunsRequest{ProviderCode: "json-provider"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.Runs, 1)
+		assert.Equal(t, "json-provider", resp.Runs[0].ProviderCode)
+		assert.Equal(t, "success", resp.Runs[0].Status)
+		assert.Equal(t, "2024-01-01T12:00:30Z", resp.Runs[0].FinishedAt)
+	})
+
+	t.Run("Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil)
+
+		resp, err := server.ListSyncRuns(ctx, &contentpb.ListSyncRunsRequest{ProviderCode: "missing"})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestContentServiceServer_GetContentRawPayload(t *testing.T) {
+	mockRawPayloadRepo := new(MockContentRawPayloadRepository)
+	mockLogger := new(MockLogger)
+
+	server := &ContentServiceServer{
+		rawPayloadUseCase: usecase.NewGetContentRawPayloadUseCase(mockRawPayloadRepo),
+		logger:            mockLogger,
+	}
+
+	ctx := context.Background()
+
+	t.Run("Found", func(t *testing.T) {
+		payload := &entity.ContentRawPayload{
+			ContentID:  1,
+			ProviderID: 2,
+			RawPayload: []byte(`{"id":"v1","metrics":{"views":100}}`),
+			FetchedAt:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		mockRawPayloadRepo.On("GetByContentID", ctx, int64(1)).Return(payload, nil)
+
+		resp, err := server.GetContentRawPayload(ctx, &contentpb.GetContentRawPayloadRequest{Id: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), resp.ProviderId)
+		assert.Equal(t, "2024-01-01T00:00:00Z", resp.FetchedAt)
+
+		fields := resp.Payload.GetStructValue().GetFields()
+		assert.Equal(t, "v1", fields["id"].GetStringValue())
+		assert.Equal(t, float64(100), fields["metrics"].GetStructValue().GetFields()["views"].GetNumberValue())
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockRawPayloadRepo.On("GetByContentID", ctx, int64(999)).Return(nil, nil)
+
+		resp, err := server.GetContentRawPayload(ctx, &contentpb.GetContentRawPayloadRequest{Id: 999})
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Payload)
 	})
 }
@@ -9,3 +9,9 @@ type MockContentStatsRepository = mocks.MockContentStatsRepository
 type MockCacheClient = mocks.MockCacheClient
 type MockLogger = mocks.MockLogger
 type MockMetadataRepository = mocks.MockMetadataRepository
+type MockProviderRepository = mocks.MockProviderRepository
+type MockSyncRunRepository = mocks.MockSyncRunRepository
+type MockContentRawPayloadRepository = mocks.MockContentRawPayloadRepository
+type MockContentScoreRepository = mocks.MockContentScoreRepository
+type MockSearchTermRepository = mocks.MockSearchTermRepository
+type MockTagRepository = mocks.MockTagRepository
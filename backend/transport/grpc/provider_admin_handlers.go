@@ -0,0 +1,411 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/application/usecase"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/ports"
+	loggerPkg "github.com/mehmetymw/search-aggregation-service/backend/infrastructure/logger"
+	contentpb "github.com/mehmetymw/search-aggregation-service/backend/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProviderAdminServer struct {
+	contentpb.UnimplementedProviderAdminServiceServer
+	saveProviderUseCase       *usecase.SaveProviderUseCase
+	setProviderEnabledUseCase *usecase.SetProviderEnabledUseCase
+	deleteProviderUseCase     *usecase.DeleteProviderUseCase
+	triggerSyncUseCase        *usecase.TriggerSyncUseCase
+	previewMappingUseCase     *usecase.PreviewProviderMappingUseCase
+	listBreakersUseCase       *usecase.ListCircuitBreakersUseCase
+	resetBreakerUseCase       *usecase.ResetCircuitBreakerUseCase
+	logger                    ports.Logger
+}
+
+func NewProviderAdminServer(
+	saveProviderUseCase *usecase.SaveProviderUseCase,
+	setProviderEnabledUseCase *usecase.SetProviderEnabledUseCase,
+	deleteProviderUseCase *usecase.DeleteProviderUseCase,
+	triggerSyncUseCase *usecase.TriggerSyncUseCase,
+	previewMappingUseCase *usecase.PreviewProviderMappingUseCase,
+	listBreakersUseCase *usecase.ListCircuitBreakersUseCase,
+	resetBreakerUseCase *usecase.ResetCircuitBreakerUseCase,
+	logger ports.Logger,
+) *ProviderAdminServer {
+	return &ProviderAdminServer{
+		saveProviderUseCase:       saveProviderUseCase,
+		setProviderEnabledUseCase: setProviderEnabledUseCase,
+		deleteProviderUseCase:     deleteProviderUseCase,
+		triggerSyncUseCase:        triggerSyncUseCase,
+		previewMappingUseCase:     previewMappingUseCase,
+		listBreakersUseCase:       listBreakersUseCase,
+		resetBreakerUseCase:       resetBreakerUseCase,
+		logger:                    logger,
+	}
+}
+
+func (s *ProviderAdminServer) CreateProvider(ctx context.Context, req *contentpb.CreateProviderRequest) (*contentpb.ProviderAdminResponse, error) {
+	result, err := s.saveProviderUseCase.Execute(ctx, usecase.SaveProviderRequest{
+		Provider: entity.Provider{
+			Code:       req.Code,
+			Name:       req.Name,
+			Format:     req.Format,
+			BaseURL:    req.BaseUrl,
+			IsEnabled:  req.IsEnabled,
+			Schedule:   fromProtoSyncSchedule(req.SyncSchedule),
+			Pagination: fromProtoFeedPagination(req.FeedPagination),
+			Mapping:    fromProtoFeedMapping(req.FeedMapping),
+			Auth:       fromProtoFeedAuth(req.FeedAuth),
+		},
+		Create: true,
+		Verify: req.Verify,
+	})
+	if err != nil {
+		return nil, s.adminError("create provider", req.Code, err)
+	}
+
+	s.logger.Info("provider created", loggerPkg.String("provider_code", result.Provider.Code))
+	return toProviderAdminResponse(result), nil
+}
+
+func (s *ProviderAdminServer) UpdateProvider(ctx context.Context, req *contentpb.UpdateProviderRequest) (*contentpb.ProviderAdminResponse, error) {
+	result, err := s.saveProviderUseCase.Execute(ctx, usecase.SaveProviderRequest{
+		Provider: entity.Provider{
+			Code:       req.Code,
+			Name:       req.Name,
+			Format:     req.Format,
+			BaseURL:    req.BaseUrl,
+			Schedule:   fromProtoSyncSchedule(req.SyncSchedule),
+			Pagination: fromProtoFeedPagination(req.FeedPagination),
+			Mapping:    fromProtoFeedMapping(req.FeedMapping),
+			Auth:       fromProtoFeedAuth(req.FeedAuth),
+		},
+		Keep: usecase.ProviderFields{
+			Name:       req.Name == "",
+			Format:     req.Format == "",
+			BaseURL:    req.BaseUrl == "",
+			Schedule:   req.SyncSchedule == nil,
+			Pagination: req.FeedPagination == nil,
+			Mapping:    req.FeedMapping == nil,
+			Auth:       req.FeedAuth == nil,
+		},
+		Verify: req.Verify,
+	})
+	if err != nil {
+		return nil, s.adminError("update provider", req.Code, err)
+	}
+
+	s.logger.Info("provider updated", loggerPkg.String("provider_code", result.Provider.Code))
+	return toProviderAdminResponse(result), nil
+}
+
+func (s *ProviderAdminServer) SetProviderEnabled(ctx context.Context, req *contentpb.SetProviderEnabledRequest) (*contentpb.ProviderAdminResponse, error) {
+	provider, err := s.setProviderEnabledUseCase.Execute(ctx, usecase.SetProviderEnabledRequest{
+		Code:    req.Code,
+		Enabled: req.IsEnabled,
+	})
+	if err != nil {
+		return nil, s.adminError("set provider enabled", req.Code, err)
+	}
+
+	return toProviderAdminResponse(&usecase.SaveProviderResult{Provider: *provider}), nil
+}
+
+func (s *ProviderAdminServer) DeleteProvider(ctx context.Context, req *contentpb.DeleteProviderRequest) (*contentpb.DeleteProviderResponse, error) {
+	if err := s.deleteProviderUseCase.Execute(ctx, req.Code); err != nil {
+		return nil, s.adminError("delete provider", req.Code, err)
+	}
+
+	s.logger.Info("provider deleted", loggerPkg.String("provider_code", req.Code))
+	return &contentpb.DeleteProviderResponse{}, nil
+}
+
+func (s *ProviderAdminServer) TriggerSync(ctx context.Context, req *contentpb.TriggerSyncRequest) (*contentpb.TriggerSyncResponse, error) {
+	result, err := s.triggerSyncUseCase.Execute(ctx, usecase.TriggerSyncRequest{ProviderCode: req.ProviderCode})
+	if err != nil {
+		return nil, s.adminError("trigger sync", req.ProviderCode, err)
+	}
+
+	runs := make([]*contentpb.SyncRun, 0, len(result.Runs))
+	for _, run := range result.Runs {
+		runs = append(runs, toProtoSyncRun(run))
+	}
+	skipped := make([]string, 0, len(result.Skipped))
+	for _, provider := range result.Skipped {
+		skipped = append(skipped, provider.Code)
+	}
+	unsupported := make([]string, 0, len(result.Unsupported))
+	for _, provider := range result.Unsupported {
+		unsupported = append(unsupported, provider.Code)
+	}
+	failed := make([]string, 0, len(result.Failed))
+	for _, res := range result.Failed {
+		s.logger.Error("failed to start sync",
+			loggerPkg.String("provider_code", res.Provider.Code),
+			loggerPkg.Error(res.Err))
+		failed = append(failed, res.Provider.Code)
+	}
+
+	s.logger.Info("sync triggered",
+		loggerPkg.String("provider_code", req.ProviderCode),
+		loggerPkg.Int("run_count", len(runs)),
+		loggerPkg.Int("skipped_count", len(skipped)),
+		loggerPkg.Int("unsupported_count", len(unsupported)),
+		loggerPkg.Int("failed_count", len(failed)))
+	return &contentpb.TriggerSyncResponse{
+		Runs:                     runs,
+		SkippedProviderCodes:     skipped,
+		UnsupportedProviderCodes: unsupported,
+		FailedProviderCodes:      failed,
+	}, nil
+}
+
+func (s *ProviderAdminServer) PreviewProviderMapping(ctx context.Context, req *contentpb.PreviewProviderMappingRequest) (*contentpb.PreviewProviderMappingResponse, error) {
+	result, err := s.previewMappingUseCase.Execute(ctx, usecase.PreviewProviderMappingRequest{
+		Provider: entity.Provider{
+			Format:     req.Format,
+			BaseURL:    req.BaseUrl,
+			Pagination: fromProtoFeedPagination(req.FeedPagination),
+			Mapping:    fromProtoFeedMapping(req.FeedMapping),
+			Auth:       fromProtoFeedAuth(req.FeedAuth),
+		},
+		Limit: int(req.Limit),
+	})
+	if err != nil {
+		return nil, s.adminError("preview provider mapping", req.BaseUrl, err)
+	}
+
+	items := make([]*contentpb.MappedItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		mapped := &contentpb.MappedItem{
+			ProviderContentId: item.ProviderContentID,
+			Title:             item.Title,
+			ContentType:       item.ContentType,
+			Views:             item.Views,
+			Likes:             item.Likes,
+			DurationSec:       item.DurationSec,
+			ReadingTime:       item.ReadingTime,
+			Reactions:         item.Reactions,
+			Comments:          item.Comments,
+			Tags:              item.Tags,
+		}
+		if !item.PublishedAt.IsZero() {
+			mapped.PublishedAt = item.PublishedAt.Format(time.RFC3339)
+		}
+		items = append(items, mapped)
+	}
+	return &contentpb.PreviewProviderMappingResponse{
+		Items:         items,
+		PageItemCount: int32(result.PageItemCount),
+	}, nil
+}
+
+func (s *ProviderAdminServer) ListCircuitBreakers(ctx context.Context, _ *contentpb.ListCircuitBreakersRequest) (*contentpb.ListCircuitBreakersResponse, error) {
+	breakers, err := s.listBreakersUseCase.Execute(ctx)
+	if err != nil {
+		return nil, s.adminError("list circuit breakers", "", err)
+	}
+
+	resp := &contentpb.ListCircuitBreakersResponse{
+		CircuitBreakers: make([]*contentpb.CircuitBreaker, 0, len(breakers)),
+	}
+	for _, breaker := range breakers {
+		resp.CircuitBreakers = append(resp.CircuitBreakers, toProtoCircuitBreaker(breaker))
+	}
+	return resp, nil
+}
+
+func (s *ProviderAdminServer) ResetCircuitBreaker(ctx context.Context, req *contentpb.ResetCircuitBreakerRequest) (*contentpb.ResetCircuitBreakerResponse, error) {
+	breaker, err := s.resetBreakerUseCase.Execute(ctx, req.Code)
+	if err != nil {
+		return nil, s.adminError("reset circuit breaker", req.Code, err)
+	}
+
+	return &contentpb.ResetCircuitBreakerResponse{CircuitBreaker: toProtoCircuitBreaker(*breaker)}, nil
+}
+
+func (s *ProviderAdminServer) adminError(action, code string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrProviderNotFound):
+		return status.Errorf(codes.NotFound, "provider %q not found", code)
+	case errors.Is(err, usecase.ErrProviderExists):
+		return status.Errorf(codes.AlreadyExists, "provider %q already exists", code)
+	case errors.Is(err, usecase.ErrInvalidProvider):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrProviderVerification):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrProviderInUse):
+		return status.Errorf(codes.FailedPrecondition, "provider %q has contents, disable it instead", code)
+	case errors.Is(err, usecase.ErrSyncInProgress):
+		return status.Errorf(codes.Aborted, "a sync of provider %q is already running", code)
+	case errors.Is(err, usecase.ErrUnsupportedProviderFormat):
+		return status.Errorf(codes.FailedPrecondition, "provider %q: %v", code, err)
+	}
+
+	s.logger.Error(action+" failed", loggerPkg.String("provider_code", code), loggerPkg.Error(err))
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+func toProviderAdminResponse(result *usecase.SaveProviderResult) *contentpb.ProviderAdminResponse {
+	provider := toProtoProvider(result.Provider)
+	provider.BaseUrl = result.Provider.BaseURL
+	provider.SyncSchedule = &contentpb.SyncSchedule{
+		IntervalSeconds: int32(result.Provider.Schedule.Interval / time.Second),
+		Cron:            result.Provider.Schedule.Cron,
+		JitterSeconds:   int32(result.Provider.Schedule.Jitter / time.Second),
+	}
+	pagination := result.Provider.Pagination
+	provider.FeedPagination = &contentpb.FeedPagination{
+		Strategy:    string(pagination.Strategy),
+		Param:       pagination.Param,
+		SizeParam:   pagination.SizeParam,
+		PageSize:    int32(pagination.PageSize),
+		FirstPage:   int32(pagination.FirstPage),
+		CursorField: pagination.CursorField,
+		MaxPages:    int32(pagination.MaxPages),
+		PageRetries: int32(pagination.PageRetries),
+	}
+	provider.FeedMapping = toProtoFeedMapping(result.Provider.Mapping)
+	provider.FeedAuth = toProtoFeedAuth(result.Provider.Auth)
+	return &contentpb.ProviderAdminResponse{
+		Provider:          provider,
+		VerifiedItemCount: int32(result.VerifiedItems),
+	}
+}
+
+func toProtoCircuitBreaker(breaker usecase.ProviderCircuitBreaker) *contentpb.CircuitBreaker {
+	return &contentpb.CircuitBreaker{
+		ProviderCode:         breaker.Provider.Code,
+		State:                breaker.Breaker.State,
+		Requests:             breaker.Breaker.Requests,
+		TotalSuccesses:       breaker.Breaker.TotalSuccesses,
+		TotalFailures:        breaker.Breaker.TotalFailures,
+		ConsecutiveSuccesses: breaker.Breaker.ConsecutiveSuccesses,
+		ConsecutiveFailures:  breaker.Breaker.ConsecutiveFailures,
+	}
+}
+
+func fromProtoSyncSchedule(schedule *contentpb.SyncSchedule) entity.SyncSchedule {
+	return entity.SyncSchedule{
+		Interval: time.Duration(schedule.GetIntervalSeconds()) * time.Second,
+		Cron:     strings.TrimSpace(schedule.GetCron()),
+		Jitter:   time.Duration(schedule.GetJitterSeconds()) * time.Second,
+	}
+}
+
+func fromProtoFeedPagination(pagination *contentpb.FeedPagination) entity.FeedPagination {
+	return entity.FeedPagination{
+		Strategy:    entity.PaginationStrategy(strings.ToLower(strings.TrimSpace(pagination.GetStrategy()))),
+		Param:       strings.TrimSpace(pagination.GetParam()),
+		SizeParam:   strings.TrimSpace(pagination.GetSizeParam()),
+		PageSize:    int(pagination.GetPageSize()),
+		FirstPage:   int(pagination.GetFirstPage()),
+		CursorField: strings.TrimSpace(pagination.GetCursorField()),
+		MaxPages:    int(pagination.GetMaxPages()),
+		PageRetries: int(pagination.GetPageRetries()),
+	}
+}
+
+func fromProtoFeedMapping(mapping *contentpb.FeedMapping) entity.FeedMapping {
+	return entity.FeedMapping{
+		Items:       strings.TrimSpace(mapping.GetItems()),
+		Delimiter:   mapping.GetDelimiter(),
+		ID:          fromProtoFieldMapping(mapping.GetId()),
+		Title:       fromProtoFieldMapping(mapping.GetTitle()),
+		Type:        fromProtoFieldMapping(mapping.GetType()),
+		Views:       fromProtoFieldMapping(mapping.GetViews()),
+		Likes:       fromProtoFieldMapping(mapping.GetLikes()),
+		Duration:    fromProtoFieldMapping(mapping.GetDuration()),
+		ReadingTime: fromProtoFieldMapping(mapping.GetReadingTime()),
+		Reactions:   fromProtoFieldMapping(mapping.GetReactions()),
+		Comments:    fromProtoFieldMapping(mapping.GetComments()),
+		PublishedAt: fromProtoFieldMapping(mapping.GetPublishedAt()),
+		Tags:        fromProtoFieldMapping(mapping.GetTags()),
+	}
+}
+
+func fromProtoFieldMapping(field *contentpb.FieldMapping) entity.FieldMapping {
+	mapped := entity.FieldMapping{
+		Path:    strings.TrimSpace(field.GetPath()),
+		Default: field.GetDefaultValue(),
+		Format:  strings.TrimSpace(field.GetFormat()),
+	}
+	for _, transform := range field.GetTransforms() {
+		mapped.Transforms = append(mapped.Transforms, entity.ValueTransform{
+			Kind:   entity.TransformKind(strings.ToLower(strings.TrimSpace(transform.GetKind()))),
+			Arg:    transform.GetArg(),
+			Values: transform.GetValues(),
+		})
+	}
+	return mapped
+}
+
+func fromProtoFeedAuth(auth *contentpb.FeedAuth) entity.FeedAuth {
+	return entity.FeedAuth{
+		Kind:       entity.FeedAuthKind(strings.ToLower(strings.TrimSpace(auth.GetKind()))),
+		Secret:     strings.TrimSpace(auth.GetSecret()),
+		HeaderName: strings.TrimSpace(auth.GetHeaderName()),
+		QueryParam: strings.TrimSpace(auth.GetQueryParam()),
+		Username:   auth.GetUsername(),
+		ClientID:   strings.TrimSpace(auth.GetClientId()),
+		TokenURL:   strings.TrimSpace(auth.GetTokenUrl()),
+		Scopes:     auth.GetScopes(),
+		Headers:    auth.GetHeaders(),
+		Query:      auth.GetQuery(),
+	}
+}
+
+func toProtoFeedAuth(auth entity.FeedAuth) *contentpb.FeedAuth {
+	return &contentpb.FeedAuth{
+		Kind:       string(auth.Kind),
+		Secret:     auth.Secret,
+		HeaderName: auth.HeaderName,
+		QueryParam: auth.QueryParam,
+		Username:   auth.Username,
+		ClientId:   auth.ClientID,
+		TokenUrl:   auth.TokenURL,
+		Scopes:     auth.Scopes,
+		Headers:    auth.Headers,
+		Query:      auth.Query,
+	}
+}
+
+func toProtoFeedMapping(mapping entity.FeedMapping) *contentpb.FeedMapping {
+	return &contentpb.FeedMapping{
+		Items:       mapping.Items,
+		Delimiter:   mapping.Delimiter,
+		Id:          toProtoFieldMapping(mapping.ID),
+		Title:       toProtoFieldMapping(mapping.Title),
+		Type:        toProtoFieldMapping(mapping.Type),
+		Views:       toProtoFieldMapping(mapping.Views),
+		Likes:       toProtoFieldMapping(mapping.Likes),
+		Duration:    toProtoFieldMapping(mapping.Duration),
+		ReadingTime: toProtoFieldMapping(mapping.ReadingTime),
+		Reactions:   toProtoFieldMapping(mapping.Reactions),
+		Comments:    toProtoFieldMapping(mapping.Comments),
+		PublishedAt: toProtoFieldMapping(mapping.PublishedAt),
+		Tags:        toProtoFieldMapping(mapping.Tags),
+	}
+}
+
+func toProtoFieldMapping(field entity.FieldMapping) *contentpb.FieldMapping {
+	mapped := &contentpb.FieldMapping{
+		Path:         field.Path,
+		DefaultValue: field.Default,
+		Format:       field.Format,
+	}
+	for _, transform := range field.Transforms {
+		mapped.Transforms = append(mapped.Transforms, &contentpb.ValueTransform{
+			Kind:   string(transform.Kind),
+			Arg:    transform.Arg,
+			Values: transform.Values,
+		})
+	}
+	return mapped
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mehmetymw/search-aggregation-service/backend/application/usecase"
+	"github.com/mehmetymw/search-aggregation-service/backend/domain/entity"
+	contentpb "github.com/mehmetymw/search-aggregation-service/backend/proto/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProviderAdminServer(t *testing.T) {
+	mockProviderRepo := new(MockProviderRepository)
+	mockMetadataRepo := new(MockMetadataRepository)
+	mockLogger := new(MockLogger)
+
+	server := NewProviderAdminServer(
+		usecase.NewSaveProviderUseCase(mockProviderRepo, mockMetadataRepo, nil, entity.FeedSecretsConfig{}),
+		usecase.NewSetProviderEnabledUseCase(mockProviderRepo),
+		usecase.NewDeleteProviderUseCase(mockProviderRepo),
+		nil,
+		nil,
+		nil,
+		nil,
+		mockLogger,
+	)
+
+	ctx := context.Background()
+	mockMetadataRepo.On("GetProviderFormats", ctx).Return([]string{"json", "xml"}, nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+
+	t.Run("Create", func(t *testing.T) {
+		provider := entity.Provider{Code: "news", Name: "News", Format: "json", BaseURL: "https://example.com/feed", IsEnabled: true}
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(nil, nil).Once()
+		mockProviderRepo.On("UpsertProvider", ctx, provider).Return(nil).Once()
+		saved := provider
+		saved.ID = 9
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&saved, nil).Once()
+
+		resp, err := server.CreateProvider(ctx, &contentpb.CreateProviderRequest{
+			Code:      "news",
+			Name:      "News",
+			Format:    "json",
+			BaseUrl:   "https://example.com/feed",
+			IsEnabled: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "news", resp.Provider.Code)
+		assert.Equal(t, "https://example.com/feed", resp.Provider.BaseUrl)
+	})
+
+	t.Run("Partial Update Keeps Other Settings", func(t *testing.T) {
+		existing := entity.Provider{
+			ID:         9,
+			Code:       "news",
+			Name:       "News",
+			Format:     "json",
+			BaseURL:    "https://example.com/feed",
+			IsEnabled:  true,
+			Schedule:   entity.SyncSchedule{Interval: time.Hour},
+			Pagination: entity.FeedPagination{Strategy: entity.PaginationPage, Param: "page"},
+			Auth:       entity.FeedAuth{Kind: entity.FeedAuthAPIKey, Secret: "env:FEED_SECRET_NEWS"},
+		}
+		updated := existing
+		updated.BaseURL = "https://example.com/v2/feed"
+		updated.Auth = entity.FeedAuth{}
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&existing, nil).Once()
+		mockProviderRepo.On("UpsertProvider", ctx, updated).Return(nil).Once()
+		mockProviderRepo.On("GetByCode", ctx, "news").Return(&updated, nil).Once()
+
+		// An empty message clears its setting.
+		resp, err := server.UpdateProvider(ctx, &contentpb.UpdateProviderRequest{
+			Code:     "news",
+			BaseUrl:  "https://example.com/v2/feed",
+			FeedAuth: &contentpb.FeedAuth{},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "News", resp.Provider.Name)
+		assert.Equal(t, "https://example.com/v2/feed", resp.Provider.BaseUrl)
+		mockProviderRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Format", func(t *testing.T) {
+		_, err := server.CreateProvider(ctx, &contentpb.CreateProviderRequest{
+			Code:    "news",
+			Name:    "News",
+			Format:  "yaml",
+			BaseUrl: "https://example.com/feed",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Disable Unknown Provider", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "missing").Return(nil, nil).Once()
+
+		_, err := server.SetProviderEnabled(ctx, &contentpb.SetProviderEnabledRequest{Code: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Delete Provider With Contents", func(t *testing.T) {
+		mockProviderRepo.On("GetByCode", ctx, "busy").Return(&entity.Provider{ID: 2, Code: "busy"}, nil).Once()
+		mockProviderRepo.On("HasContents", ctx, int64(2)).Return(true, nil).Once()
+
+		_, err := server.DeleteProvider(ctx, &contentpb.DeleteProviderRequest{Code: "busy"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
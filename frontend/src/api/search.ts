@@ -8,6 +8,15 @@ export interface ContentItem {
   score: number
   published_at: string
   provider_name: string
+  tags?: string[]
+  provider?: Provider
+}
+
+export interface Provider {
+  code: string
+  name: string
+  format: string
+  is_enabled?: boolean
 }
 
 export interface SearchResponse {
@@ -15,6 +24,30 @@ export interface SearchResponse {
   page: number
   page_size: number
   total: number
+  suggestions?: string[]
+  facets?: Facets
+}
+
+export interface FacetValue {
+  value: string
+  label: string
+  count: number
+}
+
+export interface Facets {
+  content_types?: FacetValue[]
+  providers?: FacetValue[]
+  tags?: FacetValue[]
+  published_at?: FacetValue[]
+}
+
+export interface Suggestion {
+  text: string
+  kind: 'tag' | 'title'
+}
+
+export interface SuggestResponse {
+  suggestions?: Suggestion[]
 }
 
 export interface SearchParams {
@@ -23,6 +56,13 @@ export interface SearchParams {
   sort?: string
   page?: number
   page_size?: number
+  tags?: string[]
+  exclude_tags?: string[]
+  tag_match?: 'any' | 'all'
+  include_facets?: boolean
+  published_after?: string
+  published_before?: string
+  providers?: string[]
 }
 
 export interface ContentTypeMetadata {
@@ -58,11 +98,29 @@ export async function searchContents(params: SearchParams): Promise<SearchRespon
   if (params.sort) searchParams.set('sort', params.sort)
   if (params.page) searchParams.set('page', params.page.toString())
   if (params.page_size) searchParams.set('page_size', params.page_size.toString())
+  params.tags?.forEach((tag) => searchParams.append('tags', tag))
+  params.exclude_tags?.forEach((tag) => searchParams.append('exclude_tags', tag))
+  if (params.tag_match) searchParams.set('tag_match', params.tag_match)
+  if (params.include_facets) searchParams.set('include_facets', 'true')
+  if (params.published_after) searchParams.set('published_after', params.published_after)
+  if (params.published_before) searchParams.set('published_before', params.published_before)
+  params.providers?.forEach((code) => searchParams.append('providers', code))
   
   const url = `/api/v1/search?${searchParams.toString()}`
   return fetchJSON<SearchResponse>(url)
 }
 
+export async function suggest(prefix: string, type?: string): Promise<SuggestResponse> {
+  const searchParams = new URLSearchParams({ prefix })
+  if (type) searchParams.set('type', type)
+
+  return fetchJSON<SuggestResponse>(`/api/v1/suggest?${searchParams.toString()}`)
+}
+
+export async function listProviders(): Promise<{ providers?: Provider[] }> {
+  return fetchJSON<{ providers?: Provider[] }>('/api/v1/providers')
+}
+
 export async function getContent(id: number): Promise<{ content: ContentItem }> {
   return fetchJSON<{ content: ContentItem }>(`/api/v1/contents/${id}`)
 }
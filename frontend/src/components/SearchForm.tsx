@@ -1,4 +1,5 @@
 import React from 'react'
+import { suggest, Suggestion } from '../api/search'
 
 interface ContentTypeMetadata {
   id: string
@@ -20,6 +21,7 @@ export function SearchForm({ contentTypes, sortOptions, onSearch }: Props) {
   const [query, setQuery] = React.useState('')
   const [type, setType] = React.useState('all')
   const [sort, setSort] = React.useState('')
+  const [suggestions, setSuggestions] = React.useState<Suggestion[]>([])
 
   // Set default sort only once when options load
   React.useEffect(() => {
@@ -33,16 +35,32 @@ export function SearchForm({ contentTypes, sortOptions, onSearch }: Props) {
     onSearch({ query, type, sort })
   }
 
-  // Debounced search - only trigger on user input
+  // Typing only fetches completions; a full search runs on submit or when
+  // the filters change.
   React.useEffect(() => {
     if (!sort) return // Don't search until sort is set
-    
-    const timeoutId = setTimeout(() => {
-      onSearch({ query, type, sort })
-    }, 300)
+
+    onSearch({ query, type, sort })
+  }, [type, sort]) // Removed onSearch from dependencies to prevent loop
+
+  React.useEffect(() => {
+    const prefix = query.trim()
+    if (!prefix) {
+      setSuggestions([])
+      return
+    }
+
+    const timeoutId = setTimeout(async () => {
+      try {
+        const result = await suggest(prefix, type === 'all' ? undefined : type)
+        setSuggestions(result.suggestions || [])
+      } catch (err) {
+        setSuggestions([])
+      }
+    }, 150)
 
     return () => clearTimeout(timeoutId)
-  }, [query, type, sort]) // Removed onSearch from dependencies to prevent loop
+  }, [query, type])
 
   return (
     <form onSubmit={handleSubmit} className="search-form">
@@ -55,9 +73,16 @@ export function SearchForm({ contentTypes, sortOptions, onSearch }: Props) {
           type="text"
           className="input"
           placeholder="Search for content..."
+          list="query-suggestions"
+          autoComplete="off"
           value={query}
           onChange={(e) => setQuery(e.target.value)}
         />
+        <datalist id="query-suggestions">
+          {suggestions.map((s) => (
+            <option key={`${s.kind}:${s.text}`} value={s.text} />
+          ))}
+        </datalist>
       </div>
 
       <div className="form-group">